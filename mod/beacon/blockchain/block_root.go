@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// blockRootCacheSize is the number of block roots kept by the block root
+// cache.
+const blockRootCacheSize = 32
+
+// blockRootCache caches the roots of blocks by the root of their header as
+// stored in the state, i.e. without a state root. That root identifies the
+// block, and thus the state it results in, so a cached root can never belong
+// to another block.
+type blockRootCache struct {
+	mu sync.Mutex
+	// roots maps the root of a stored header to the root of its block.
+	roots map[common.Root]common.Root
+	// keys holds the keys of roots in insertion order, so that the oldest
+	// one is evicted first.
+	keys []common.Root
+}
+
+// newBlockRootCache creates a new, empty blockRootCache.
+func newBlockRootCache() *blockRootCache {
+	return &blockRootCache{
+		roots: make(map[common.Root]common.Root, blockRootCacheSize),
+		keys:  make([]common.Root, 0, blockRootCacheSize),
+	}
+}
+
+// get returns the cached root of the block whose stored header has the given
+// root, if any.
+func (c *blockRootCache) get(headerRoot common.Root) (common.Root, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	root, ok := c.roots[headerRoot]
+	return root, ok
+}
+
+// add caches the root of the block whose stored header has the given root,
+// evicting the oldest entry if the cache is full.
+func (c *blockRootCache) add(headerRoot, root common.Root) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.roots[headerRoot]; ok {
+		return
+	}
+	if len(c.keys) >= blockRootCacheSize {
+		delete(c.roots, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.roots[headerRoot] = root
+	c.keys = append(c.keys, headerRoot)
+}
+
+// ComputeBlockRoot returns the root of the given block, which must be the
+// latest block applied to the given state.
+//
+// The state only holds the header of its latest block, and that header is
+// stored with a zero state root: the root of the post-block state cannot be
+// part of the state itself, so it is only filled in when the next slot is
+// processed. Hashing the stored header as is therefore yields a root that
+// matches no block. Until the next slot is processed, the header must first
+// be completed with the root of the state, which is what this does. Once the
+// next slot is processed, the header already carries the state root and the
+// state itself has moved on, so its root must not be used.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) ComputeBlockRoot(st BeaconStateT, blk BeaconBlockT) (common.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if header.GetSlot() != blk.GetSlot() {
+		return common.Root{}, errors.Wrapf(
+			ErrBlockHeaderSlotMismatch,
+			"block slot: %d, latest block header slot: %d",
+			blk.GetSlot(), header.GetSlot(),
+		)
+	}
+	return s.latestBlockRoot(st, header)
+}
+
+// latestBlockRoot returns the root of the latest block applied to the given
+// state, whose header is given. See ComputeBlockRoot for why the header alone
+// does not suffice.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) latestBlockRoot(
+	st BeaconStateT,
+	header BeaconBlockHeaderT,
+) (common.Root, error) {
+	if header.GetStateRoot() != (common.Root{}) {
+		return header.HashTreeRoot()
+	}
+
+	// Hashing the state is expensive, so the root is cached by the root of
+	// the incomplete header, which is cheap to compute.
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if root, ok := s.blockRoots.get(headerRoot); ok {
+		return root, nil
+	}
+
+	stateRoot, err := st.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	header.SetStateRoot(stateRoot)
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	s.blockRoots.add(headerRoot, root)
+	return root, nil
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// TestBlockRootCache tests that block roots are cached by header root and
+// that the oldest entry is evicted once the cache is full.
+func TestBlockRootCache(t *testing.T) {
+	c := newBlockRootCache()
+	for i := range blockRootCacheSize + 1 {
+		c.add(common.Root{byte(i)}, common.Root{byte(i), 1})
+	}
+
+	if _, ok := c.get(common.Root{0}); ok {
+		t.Fatal("expected the oldest entry to be evicted")
+	}
+	for i := 1; i <= blockRootCacheSize; i++ {
+		root, ok := c.get(common.Root{byte(i)})
+		if !ok || root != (common.Root{byte(i), 1}) {
+			t.Fatalf("expected entry %d to be cached, got %s", i, root)
+		}
+	}
+}
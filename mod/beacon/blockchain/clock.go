@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// maximumClockDisparity is the maximum clock disparity tolerated between
+// nodes, matching MAXIMUM_GOSSIP_CLOCK_DISPARITY of the Ethereum spec.
+const maximumClockDisparity = 500 * time.Millisecond
+
+// Clock provides the service with its notion of time, so that time dependent
+// checks can be exercised deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// TimeAtSlot returns the time at which the given slot is expected to
+	// start.
+	TimeAtSlot(slot math.Slot) (time.Time, error)
+}
+
+// GenesisTimeSource provides the genesis time of the chain as a unix
+// timestamp in seconds.
+type GenesisTimeSource interface {
+	// GenesisTime returns the genesis time of the chain.
+	GenesisTime() (uint64, error)
+}
+
+// SpecClock is a Clock backed by the wall clock and the chain spec. Slots are
+// SecondsPerSlot apart, starting at the genesis time.
+type SpecClock struct {
+	// cs is the chain specification for the beacon chain.
+	cs common.ChainSpec
+	// genesis provides the time of the genesis slot.
+	genesis GenesisTimeSource
+}
+
+// NewSpecClock creates a new SpecClock.
+func NewSpecClock(cs common.ChainSpec, genesis GenesisTimeSource) *SpecClock {
+	return &SpecClock{
+		cs:      cs,
+		genesis: genesis,
+	}
+}
+
+// Now returns the current wall clock time.
+func (c *SpecClock) Now() time.Time {
+	return time.Now()
+}
+
+// TimeAtSlot returns the time at which the given slot is expected to start.
+// It fails if the genesis time is not known yet.
+func (c *SpecClock) TimeAtSlot(slot math.Slot) (time.Time, error) {
+	genesisTime, err := c.genesis.GenesisTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	//#nosec:G701 // the time will never exceed int64 max.
+	return time.Unix(int64(c.cs.TimeAtSlot(slot, genesisTime)), 0), nil
+}
+
+// verifyPayloadTimestamp rejects execution payloads that are timestamped
+// further in the future than the given drift allows. It reads the wall
+// clock, so it must only be used when verifying proposals and never while
+// finalizing blocks, where all nodes must reach the same result.
+func verifyPayloadTimestamp(
+	clock Clock,
+	maxDrift time.Duration,
+	timestamp math.U64,
+) error {
+	//#nosec:G701 // the timestamp will never exceed int64 max.
+	payloadTime := time.Unix(int64(timestamp.Unwrap()), 0)
+	if limit := clock.Now().Add(maxDrift); payloadTime.After(limit) {
+		return errors.Wrapf(
+			ErrPayloadTimestampInFuture,
+			"payload timestamp %s is after %s", payloadTime, limit,
+		)
+	}
+	return nil
+}
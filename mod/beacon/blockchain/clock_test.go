@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// fixedClock is a Clock that is stuck at a fixed time.
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+func (c fixedClock) TimeAtSlot(math.Slot) (time.Time, error) {
+	return c.now, nil
+}
+
+// genesisTimeFunc adapts a function to a GenesisTimeSource.
+type genesisTimeFunc func() (uint64, error)
+
+func (f genesisTimeFunc) GenesisTime() (uint64, error) {
+	return f()
+}
+
+// TestSpecClockTimeAtSlot tests that slots are counted from the genesis time
+// provided to the SpecClock.
+func TestSpecClockTimeAtSlot(t *testing.T) {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SecondsPerSlot: 2,
+		},
+	)
+
+	clock := NewSpecClock(cs, genesisTimeFunc(func() (uint64, error) {
+		return 1_000, nil
+	}))
+	got, err := clock.TimeAtSlot(5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Unix(1_010, 0); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	clock = NewSpecClock(cs, genesisTimeFunc(func() (uint64, error) {
+		return 0, ErrGenesisTimeNotSet
+	}))
+	if _, err = clock.TimeAtSlot(5); !errors.Is(err, ErrGenesisTimeNotSet) {
+		t.Fatalf("expected %v, got %v", ErrGenesisTimeNotSet, err)
+	}
+}
+
+// TestVerifyPayloadTimestamp tests that payloads are accepted up to the
+// maximum drift ahead of the clock, and rejected beyond it.
+func TestVerifyPayloadTimestamp(t *testing.T) {
+	clock := fixedClock{now: time.Unix(1_000, 0)}
+	maxDrift := 2*time.Second + maximumClockDisparity
+
+	tests := []struct {
+		name      string
+		timestamp math.U64
+		wantErr   bool
+	}{
+		{name: "In the past", timestamp: 900, wantErr: false},
+		{name: "Now", timestamp: 1_000, wantErr: false},
+		{name: "Within drift", timestamp: 1_002, wantErr: false},
+		{name: "Beyond drift", timestamp: 1_003, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyPayloadTimestamp(clock, maxDrift, tt.timestamp)
+			if tt.wantErr != errors.Is(err, ErrPayloadTimestampInFuture) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import "time"
+
+const (
+	// defaultOptimisticModeEnabled is the default for optimistically
+	// importing blocks whose payloads are not yet validated.
+	defaultOptimisticModeEnabled = true
+	// defaultForkchoiceUpdateAttempts is the default number of attempts made
+	// to deliver a forkchoice update to the execution client.
+	defaultForkchoiceUpdateAttempts = 3
+	// defaultForkchoiceUpdateRetryDelay is the default delay before the first
+	// retry of a forkchoice update.
+	defaultForkchoiceUpdateRetryDelay = 500 * time.Millisecond
+	// defaultAvailabilityStoreBackend is the default backend of the
+	// availability store.
+	defaultAvailabilityStoreBackend = AvailabilityStoreBackendDisk
+)
+
+const (
+	// AvailabilityStoreBackendDisk stores blob sidecars on disk.
+	AvailabilityStoreBackendDisk = "disk"
+	// AvailabilityStoreBackendMemory stores blob sidecars in memory. They
+	// are lost on restart.
+	AvailabilityStoreBackendMemory = "memory"
+)
+
+// Config is the configuration for the blockchain service.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// OptimisticModeEnabled determines whether blocks are imported when the
+	// execution client responds to a payload with SYNCING or ACCEPTED.
+	//
+	// When enabled, such blocks are accepted and tracked as unvalidated until
+	// the execution client reports their payload as VALID. This allows the
+	// node to keep up with the chain while its execution client is syncing,
+	// at the cost of temporarily building on payloads that may later turn out
+	// to be INVALID.
+	//
+	// When disabled, the service refuses any block whose payload has not
+	// been fully validated by the execution client. This is the safer mode,
+	// but any execution client hiccup during block finalization will halt
+	// the node.
+	OptimisticModeEnabled bool `mapstructure:"optimistic-mode-enabled"`
+	// ForkchoiceUpdateAttempts is the maximum number of attempts made to
+	// deliver a forkchoice update when the execution client cannot be
+	// reached. Explicit responses from the execution client, such as an
+	// INVALID status, are never retried.
+	ForkchoiceUpdateAttempts uint64 `mapstructure:"forkchoice-update-attempts"`
+	// ForkchoiceUpdateRetryDelay is the delay before the first retry of a
+	// forkchoice update. The delay doubles with every further retry.
+	ForkchoiceUpdateRetryDelay time.Duration `mapstructure:"forkchoice-update-retry-delay"`
+	// AvailabilityStoreBackend selects where blob sidecars are stored, either
+	// "disk" or "memory". The memory backend suits small nodes that do not
+	// need to serve blobs across restarts, while archival nodes should keep
+	// them on disk. Both backends are pruned outside of the DA period.
+	AvailabilityStoreBackend string `mapstructure:"availability-store-backend"`
+	// SequentialValidation validates the execution payload, validates the
+	// block, processes its blobs and processes the block strictly in order
+	// on a single goroutine, instead of in parallel. It is slower, but makes
+	// logs and stack traces deterministic when debugging a block that fails
+	// validation.
+	SequentialValidation bool `mapstructure:"sequential-validation"`
+}
+
+// DefaultConfig returns the default blockchain service configuration.
+func DefaultConfig() Config {
+	return Config{
+		OptimisticModeEnabled:      defaultOptimisticModeEnabled,
+		ForkchoiceUpdateAttempts:   defaultForkchoiceUpdateAttempts,
+		ForkchoiceUpdateRetryDelay: defaultForkchoiceUpdateRetryDelay,
+		AvailabilityStoreBackend:   defaultAvailabilityStoreBackend,
+	}
+}
@@ -20,12 +20,18 @@
 
 package blockchain
 
-import "github.com/berachain/beacon-kit/mod/errors"
+import (
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
 
 var (
 	// ErrInvalidPayload indicates that the payload of a beacon block is
 	// invalid.
 	ErrInvalidPayload = errors.New("invalid payload")
+	// ErrPayloadSyncing indicates that the execution client could not
+	// validate the payload of a beacon block because it is still syncing.
+	ErrPayloadSyncing = errors.New("payload syncing")
 	// ErrNoPayloadInBeaconBlock indicates that a beacon block was expected to
 	// have a payload, but none was found.
 	ErrNoPayloadInBeaconBlock = errors.New("no payload in beacon block")
@@ -33,6 +39,67 @@ var (
 	ErrNilBlkBody = errors.New("nil block body")
 	// ErrNilBlk is an error for when the beacon block is nil.
 	ErrNilBlk = errors.New("nil beacon block")
+	// ErrGenesisForkVersionMismatch is returned when the genesis fork version
+	// does not match the fork active at slot 0 in the chain spec.
+	ErrGenesisForkVersionMismatch = errors.New(
+		"genesis fork version mismatch",
+	)
+	// ErrInvalidGenesisTime is returned when the genesis execution payload is
+	// timestamped before the minimum genesis time of the chain spec.
+	ErrInvalidGenesisTime = errors.New("invalid genesis time")
+	// ErrGenesisTimeNotSet is returned when the genesis time is requested
+	// before it is known to the service.
+	ErrGenesisTimeNotSet = errors.New("genesis time not set")
+	// ErrPayloadTimestampInFuture is returned when the execution payload of
+	// a proposed block is timestamped further ahead of the local clock than
+	// tolerated.
+	ErrPayloadTimestampInFuture = errors.New(
+		"payload timestamp too far in the future",
+	)
+	// ErrSlotAlreadyProcessed is returned when a block is received for a slot
+	// at or below the latest processed slot.
+	ErrSlotAlreadyProcessed = errors.New("slot already processed")
+	// ErrSlotGap is returned when a block skips slots without building on
+	// the latest processed block, meaning that blocks were lost on delivery.
+	ErrSlotGap = errors.New("gap between processed slots")
+	// ErrMissingBlobSidecars is returned when a block declares blob
+	// commitments but is received without its blob sidecars.
+	ErrMissingBlobSidecars = errors.New("missing blob sidecars")
+	// ErrUnexpectedBlobSidecars is returned when a block is received with blob
+	// sidecars but declares no blob commitments.
+	ErrUnexpectedBlobSidecars = errors.New("unexpected blob sidecars")
+	// ErrBlockHeaderSlotMismatch is returned when the root of a block is
+	// computed from a state whose latest block is at another slot.
+	ErrBlockHeaderSlotMismatch = errors.New("block header slot mismatch")
 	// ErrDataNotAvailable.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrPayloadNotOptimistic is returned when attempting to invalidate a
+	// payload that was not optimistically imported.
+	ErrPayloadNotOptimistic = errors.New("payload not optimistically imported")
+	// ErrNoValidPayload is returned when the execution client head cannot be
+	// moved back after an invalid payload, since no payload has been reported
+	// as VALID yet to mark as safe and finalized.
+	ErrNoValidPayload = errors.New("no payload reported as valid")
+	// ErrForkchoiceUpdateFailed is returned when a forkchoice update could
+	// not be delivered to the execution client.
+	ErrForkchoiceUpdateFailed = errors.New("forkchoice update failed")
 )
+
+// wrapPayloadErr tags payload status errors returned by the execution client
+// with the matching error of this package, so that callers can branch on
+// them with errors.Is without depending on the engine errors. Other errors
+// are returned unchanged.
+func wrapPayloadErr(err error) error {
+	switch {
+	case errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	):
+		return errors.Join(ErrInvalidPayload, err)
+	case errors.Is(err, engineerrors.ErrSyncingPayloadStatus):
+		return errors.Join(ErrPayloadSyncing, err)
+	default:
+		return err
+	}
+}
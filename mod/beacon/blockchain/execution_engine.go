@@ -25,9 +25,15 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// sendPostBlockFCU sends a forkchoice update to the execution client.
+// sendPostBlockFCU sends a forkchoice update to the execution client. It
+// returns the payload status reported by the execution client, or nil if it
+// is not known, e.g. when the update was sent along with payload attributes.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -43,20 +49,97 @@ func (s *Service[
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) {
+) *engineprimitives.PayloadStatusV1 {
 	lph, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
 		s.logger.Error(
 			"failed to get latest execution payload in postBlockProcess",
 			"error", err,
 		)
-		return
+		return nil
 	}
 
 	if !s.shouldBuildOptimisticPayloads() && s.lb.Enabled() {
 		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
+		return nil
+	}
+	return s.sendNextFCUWithoutAttributes(ctx, blk.GetSlot(), lph)
+}
+
+// ForceFCU sends a forkchoice update for the latest execution payload of the
+// state in the given context. It is meant to follow a batch of blocks
+// processed with ProcessBlockAndBlobsWithoutFCU. A SYNCING response from the
+// execution client is not an error.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) ForceFCU(ctx context.Context) error {
+	st := s.sb.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return err
+	}
+
+	status := s.sendNextFCUWithoutAttributes(ctx, slot, lph)
+	switch {
+	case status == nil:
+		return ErrForkchoiceUpdateFailed
+	case status.Status == engineprimitives.PayloadStatusInvalid:
+		return errors.Wrapf(
+			ErrInvalidPayload, "head block hash: %s", lph.GetBlockHash(),
+		)
+	default:
+		return nil
+	}
+}
+
+// sendGenesisFCU sends a forkchoice update to the execution client, setting
+// the genesis execution payload as head, safe and finalized block.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) sendGenesisFCU(
+	ctx context.Context,
+	header ExecutionPayloadHeaderT,
+) {
+	genesisHash := header.GetBlockHash()
+	if _, _, err := s.notifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      genesisHash,
+				SafeBlockHash:      genesisHash,
+				FinalizedBlockHash: genesisHash,
+			},
+			nil,
+			s.cs.ActiveForkVersionForSlot(0),
+		),
+	); err != nil {
+		s.logger.Error(
+			"failed to send genesis forkchoice update",
+			"block_hash", genesisHash,
+			"error", err,
+		)
 	}
 }
 
@@ -106,16 +189,17 @@ func (s *Service[
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
 	); err != nil {
-		s.logger.Error(
+		s.logForkchoiceFailure(
 			"failed to send forkchoice update with attributes in non-optimistic payload",
-			"error",
 			err,
 		)
 	}
 }
 
 // sendNextFCUWithoutAttributes sends a forkchoice update to the
-// execution client without attributes.
+// execution client without attributes and returns the payload status it
+// reported, or nil if the update failed. An INVALID status invalidates the
+// optimistically imported head payload.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -129,28 +213,233 @@ func (s *Service[
 	GenesisT,
 ]) sendNextFCUWithoutAttributes(
 	ctx context.Context,
-	blk BeaconBlockT,
+	slot math.Slot,
 	lph ExecutionPayloadHeaderT,
-) {
-	if _, _, err := s.ee.NotifyForkchoiceUpdate(
+) *engineprimitives.PayloadStatusV1 {
+	headHash := lph.GetBlockHash()
+	_, latestValidHash, err := s.notifyForkchoiceUpdate(
 		ctx,
 		engineprimitives.BuildForkchoiceUpdateRequest(
 			&engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
+				HeadBlockHash:      headHash,
 				SafeBlockHash:      lph.GetParentHash(),
 				FinalizedBlockHash: lph.GetParentHash(),
 			},
 			nil,
-			s.cs.ActiveForkVersionForSlot(blk.GetSlot()),
+			s.cs.ActiveForkVersionForSlot(slot),
 		),
-	); err != nil {
+	)
+
+	switch {
+	case errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	):
 		s.logger.Error(
-			"failed to send forkchoice update without attributes",
-			"error", err,
+			"Execution client reported forkchoice head as INVALID",
+			"head_block_hash", headHash,
+			"latest_valid_hash", latestValidHash,
 		)
+		// Payloads that were not imported optimistically have already been
+		// validated and have nothing to invalidate.
+		if err = s.InvalidatePayload(ctx, headHash); err != nil &&
+			!errors.Is(err, ErrPayloadNotOptimistic) {
+			s.logger.Error(
+				"failed to invalidate payload", "error", err,
+			)
+		}
+		return &engineprimitives.PayloadStatusV1{
+			Status:          engineprimitives.PayloadStatusInvalid,
+			LatestValidHash: latestValidHash,
+		}
+	case err != nil:
+		s.logForkchoiceFailure(
+			"failed to send forkchoice update without attributes", err,
+		)
+		return nil
+	// The execution engine reports SYNCING and ACCEPTED responses without
+	// a latest valid hash.
+	case latestValidHash == nil:
+		s.logger.Info(
+			"Execution client is syncing, forkchoice head not yet validated",
+			"head_block_hash", headHash,
+		)
+		return &engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusSyncing,
+		}
+	default:
+		// A VALID response means the head payload, and thus all of its
+		// ancestors, have been fully validated by the execution client.
+		s.optimisticPayloads.markValid(*latestValidHash)
+		return &engineprimitives.PayloadStatusV1{
+			Status:          engineprimitives.PayloadStatusValid,
+			LatestValidHash: latestValidHash,
+		}
 	}
 }
 
+// notifyForkchoiceUpdate sends a forkchoice update to the execution client,
+// retrying with exponential backoff while the execution client cannot be
+// reached. Explicit responses from the execution client are returned without
+// retrying.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) notifyForkchoiceUpdate(
+	ctx context.Context,
+	req *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	var (
+		attempts = max(s.cfg.ForkchoiceUpdateAttempts, 1)
+		delay    = s.cfg.ForkchoiceUpdateRetryDelay
+	)
+	for attempt := uint64(1); ; attempt++ {
+		payloadID, latestValidHash, err := s.ee.NotifyForkchoiceUpdate(
+			ctx, req,
+		)
+		if err == nil ||
+			attempt >= attempts ||
+			!isRetryableForkchoiceErr(err, latestValidHash) {
+			return payloadID, latestValidHash, err
+		}
+
+		if suppressed, ok := s.fcuLogLimiter.allow(
+			"retry: " + err.Error(),
+		); ok {
+			s.logger.Warn(
+				"Failed to send forkchoice update, retrying",
+				"attempt", attempt,
+				"max_attempts", attempts,
+				"retry_in", delay,
+				"error", err,
+				"num_suppressed", suppressed,
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// logForkchoiceFailure logs a forkchoice update that failed for good, i.e.
+// after retrying if the error was retryable. Identical failures are only
+// logged once per cooldown, and failures caused by the node shutting down are
+// logged as warnings.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) logForkchoiceFailure(msg string, err error) {
+	suppressed, ok := s.fcuLogLimiter.allow(msg + ": " + err.Error())
+	if !ok {
+		return
+	}
+
+	if errors.IsAny(err, context.Canceled, context.DeadlineExceeded) {
+		s.logger.Warn(msg, "error", err, "num_suppressed", suppressed)
+		return
+	}
+	s.logger.Error(msg, "error", err, "num_suppressed", suppressed)
+}
+
+// isRetryableForkchoiceErr reports whether a forkchoice update failed
+// because the execution client could not be reached, rather than because it
+// rejected the update. A response carrying a latest valid hash was produced
+// by the execution client.
+func isRetryableForkchoiceErr(
+	err error,
+	latestValidHash *common.ExecutionHash,
+) bool {
+	return latestValidHash == nil && !errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+		engineerrors.ErrPreDefinedJSONRPC,
+		engineerrors.ErrUnsupportedEngineMethod,
+		context.Canceled,
+		context.DeadlineExceeded,
+	)
+}
+
+// InvalidatePayload handles the execution client reporting a previously
+// optimistically imported payload as INVALID. The payload and all of its
+// descendants are dropped from the set of optimistic payloads, and the
+// execution client head is moved back to the parent of the invalid payload,
+// which is not necessarily valid itself, so only the last payload reported as
+// VALID is marked as safe and finalized. If no payload has been reported as
+// VALID yet, the execution client is not updated and ErrNoValidPayload is
+// returned, since a zero finalized hash is not a valid forkchoice state.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) InvalidatePayload(
+	ctx context.Context,
+	blockHash common.ExecutionHash,
+) error {
+	invalid, numRemoved, ok := s.optimisticPayloads.invalidate(blockHash)
+	if !ok {
+		return errors.Wrapf(
+			ErrPayloadNotOptimistic, "block hash %s", blockHash.Hex(),
+		)
+	}
+
+	s.logger.Warn(
+		"Invalidated optimistically imported payload ⛔",
+		"block_hash", blockHash,
+		"slot", invalid.slot.Base10(),
+		"num_removed", numRemoved,
+	)
+	s.metrics.markOptimisticPayloadInvalidated(invalid.slot, numRemoved)
+
+	// The parent of the invalid payload is the new head of the chain.
+	lastValidHash := s.optimisticPayloads.lastValidHash()
+	if lastValidHash == (common.ExecutionHash{}) {
+		return errors.Wrapf(
+			ErrNoValidPayload, "invalid block hash %s", blockHash.Hex(),
+		)
+	}
+	_, _, err := s.ee.NotifyForkchoiceUpdate(
+		ctx,
+		engineprimitives.BuildForkchoiceUpdateRequest(
+			&engineprimitives.ForkchoiceStateV1{
+				HeadBlockHash:      invalid.parentHash,
+				SafeBlockHash:      lastValidHash,
+				FinalizedBlockHash: lastValidHash,
+			},
+			nil,
+			s.cs.ActiveForkVersionForSlot(invalid.slot),
+		),
+	)
+	return err
+}
+
 // calculateNextTimestamp calculates the next timestamp for an execution
 // payload.
 //
@@ -169,7 +458,7 @@ func (s *Service[
 ]) calculateNextTimestamp(blk BeaconBlockT) uint64 {
 	//#nosec:G701 // not an issue in practice.
 	return max(
-		uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
+		uint64(time.Now().Unix()+int64(s.cs.SecondsPerSlot())),
 		uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
 	)
 }
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// CurrentHead returns the execution block hash of the current head and the
+// slot of the beacon state it belongs to. There is no separate forkchoice
+// store, since blocks are final once committed, so the head is the latest
+// execution payload header in the committed beacon state.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) CurrentHead(
+	ctx context.Context,
+) (common.ExecutionHash, math.Slot, error) {
+	st := s.sb.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return common.ExecutionHash{}, 0, errors.Wrap(
+			err, "failed to get slot",
+		)
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return common.ExecutionHash{}, 0, errors.Wrap(
+			err, "failed to get latest execution payload header",
+		)
+	}
+	return lph.GetBlockHash(), slot, nil
+}
+
+// DebugInfo is a snapshot of the roots of the current beacon state, meant for
+// diagnostics.
+type DebugInfo struct {
+	// Slot is the slot of the beacon state.
+	Slot math.Slot
+	// StateRoot is the hash tree root of the beacon state.
+	StateRoot common.Root
+	// LatestBlockHeaderRoot is the hash tree root of the latest block header
+	// as stored in the beacon state. Its state root is only filled in when
+	// the next slot is processed.
+	LatestBlockHeaderRoot common.Root
+	// LatestExecutionPayloadHash is the block hash of the latest execution
+	// payload, which is also the current head, see CurrentHead.
+	LatestExecutionPayloadHash common.ExecutionHash
+}
+
+// DebugSnapshot gathers the roots of the current beacon state in one place.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) DebugSnapshot(ctx context.Context) (*DebugInfo, error) {
+	st := s.sb.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get slot")
+	}
+
+	stateRoot, err := st.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state root")
+	}
+
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block header")
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest block header root")
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrap(
+			err, "failed to get latest execution payload header",
+		)
+	}
+
+	return &DebugInfo{
+		Slot:                       slot,
+		StateRoot:                  stateRoot,
+		LatestBlockHeaderRoot:      headerRoot,
+		LatestExecutionPayloadHash: lph.GetBlockHash(),
+	}, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+	"time"
+)
+
+// fcuLogCooldown is the window in which repeated identical forkchoice update
+// failures are only logged once.
+const fcuLogCooldown = 30 * time.Second
+
+// logLimiter deduplicates log lines, so that a failure repeating on every
+// block, e.g. while the execution client restarts, does not flood the logs.
+type logLimiter struct {
+	mu sync.Mutex
+	// cooldown is the window in which a key is logged at most once.
+	cooldown time.Duration
+	// lastLogged is the time each key was last logged at.
+	lastLogged map[string]time.Time
+	// suppressed counts the suppressed log lines of each key since it was
+	// last logged.
+	suppressed map[string]uint64
+}
+
+// newLogLimiter creates a new logLimiter with the given cooldown.
+func newLogLimiter(cooldown time.Duration) *logLimiter {
+	return &logLimiter{
+		cooldown:   cooldown,
+		lastLogged: make(map[string]time.Time),
+		suppressed: make(map[string]uint64),
+	}
+}
+
+// allow reports whether a log line with the given key should be logged, and
+// if so, how many were suppressed since it was last logged.
+func (l *logLimiter) allow(key string) (uint64, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if last, ok := l.lastLogged[key]; ok && now.Sub(last) < l.cooldown {
+		l.suppressed[key]++
+		return 0, false
+	}
+
+	suppressed := l.suppressed[key]
+	// Drop keys whose cooldown has passed, so that the maps stay small.
+	for k, last := range l.lastLogged {
+		if now.Sub(last) >= l.cooldown {
+			delete(l.lastLogged, k)
+			delete(l.suppressed, k)
+		}
+	}
+
+	l.lastLogged[key] = now
+	delete(l.suppressed, key)
+	return suppressed, true
+}
@@ -21,6 +21,8 @@
 package blockchain
 
 import (
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -30,6 +32,19 @@ import (
 type chainMetrics struct {
 	// sink is the sink for the metrics.
 	sink TelemetrySink
+	// blocksProcessed is the total number of blocks processed.
+	blocksProcessed atomic.Uint64
+	// lastProcessedSlot is the slot of the most recently processed block.
+	lastProcessedSlot atomic.Uint64
+}
+
+// ProcessingMetrics is a point-in-time view of the block processing progress
+// of the blockchain service.
+type ProcessingMetrics struct {
+	// BlocksProcessed is the total number of blocks processed.
+	BlocksProcessed uint64
+	// LastProcessedSlot is the slot of the most recently processed block.
+	LastProcessedSlot math.Slot
 }
 
 // newChainMetrics creates a new chainMetrics.
@@ -60,6 +75,16 @@ func (cm *chainMetrics) measureBlobProcessingDuration(start time.Time) {
 	)
 }
 
+// markBlobsProcessed adds the number of blobs processed for a block to the
+// processed blobs counter.
+func (cm *chainMetrics) markBlobsProcessed(numBlobs int) {
+	//#nosec:G701 // the number of blobs is never negative.
+	cm.sink.AddCounter(
+		"beacon_kit.blockchain.blobs_processed",
+		uint64(numBlobs),
+	)
+}
+
 // markRebuildPayloadForRejectedBlockSuccess increments the counter for the
 // number of times
 // the validator successfully rebuilt the payload for a rejected block.
@@ -124,3 +149,39 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markOptimisticPayloadInvalidated increments the counter for the number of
+// times an optimistically imported payload was reported as invalid.
+func (cm *chainMetrics) markOptimisticPayloadInvalidated(
+	slot math.Slot,
+	numRemoved int,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.optimistic_payload_invalidated",
+		"slot",
+		slot.Base10(),
+		"num_removed",
+		strconv.Itoa(numRemoved),
+	)
+}
+
+// markBlockProcessed increments the processed blocks counter and records the
+// slot of the processed block.
+func (cm *chainMetrics) markBlockProcessed(slot math.Slot) {
+	cm.blocksProcessed.Add(1)
+	cm.lastProcessedSlot.Store(slot.Unwrap())
+	cm.sink.IncrementCounter("beacon_kit.blockchain.blocks_processed")
+	//#nosec:G701 // the slot will never exceed int64 max.
+	cm.sink.SetGauge(
+		"beacon_kit.blockchain.last_processed_slot",
+		int64(slot.Unwrap()),
+	)
+}
+
+// snapshot returns the current processing metrics.
+func (cm *chainMetrics) snapshot() ProcessingMetrics {
+	return ProcessingMetrics{
+		BlocksProcessed:   cm.blocksProcessed.Load(),
+		LastProcessedSlot: math.Slot(cm.lastProcessedSlot.Load()),
+	}
+}
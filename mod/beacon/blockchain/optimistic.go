@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// optimisticPayloadRetention is the number of slots an unvalidated payload
+// is tracked for. Older payloads are built upon by blocks that have long been
+// finalized by consensus and are no longer tracked.
+const optimisticPayloadRetention = 64
+
+// optimisticPayload is an execution payload that was imported without being
+// fully validated by the execution client.
+type optimisticPayload struct {
+	// parentHash is the hash of the parent execution payload.
+	parentHash common.ExecutionHash
+	// slot is the slot of the beacon block containing the payload.
+	slot math.Slot
+}
+
+// optimisticPayloads tracks the execution payloads that were imported
+// optimistically and have not yet been reported as VALID.
+type optimisticPayloads struct {
+	mu       sync.RWMutex
+	payloads map[common.ExecutionHash]optimisticPayload
+	// lastValid is the hash of the last payload reported as VALID.
+	lastValid common.ExecutionHash
+}
+
+// newOptimisticPayloads creates a new optimisticPayloads tracker.
+func newOptimisticPayloads() *optimisticPayloads {
+	return &optimisticPayloads{
+		payloads: make(map[common.ExecutionHash]optimisticPayload),
+	}
+}
+
+// insert records the payload with the given hash as unvalidated.
+func (o *optimisticPayloads) insert(
+	hash, parentHash common.ExecutionHash,
+	slot math.Slot,
+) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.payloads[hash] = optimisticPayload{
+		parentHash: parentHash,
+		slot:       slot,
+	}
+}
+
+// markValid marks the payload with the given hash, and all of its
+// unvalidated ancestors, as validated.
+func (o *optimisticPayloads) markValid(hash common.ExecutionHash) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.lastValid = hash
+	for {
+		payload, ok := o.payloads[hash]
+		if !ok {
+			return
+		}
+		delete(o.payloads, hash)
+		hash = payload.parentHash
+	}
+}
+
+// lastValidHash returns the hash of the last payload reported as VALID, or
+// the zero hash if no payload has been validated yet.
+func (o *optimisticPayloads) lastValidHash() common.ExecutionHash {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.lastValid
+}
+
+// invalidate removes the payload with the given hash and all of its tracked
+// descendants. It returns the removed payload and the number of payloads
+// removed, or false if the payload is not tracked.
+func (o *optimisticPayloads) invalidate(
+	hash common.ExecutionHash,
+) (optimisticPayload, int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	invalid, ok := o.payloads[hash]
+	if !ok {
+		return optimisticPayload{}, 0, false
+	}
+
+	// Walk the tracked payloads, removing every payload that descends from
+	// the invalid one.
+	removed := map[common.ExecutionHash]struct{}{hash: {}}
+	for changed := true; changed; {
+		changed = false
+		for h, payload := range o.payloads {
+			if _, isRemoved := removed[h]; isRemoved {
+				continue
+			}
+			if _, parentRemoved := removed[payload.parentHash]; parentRemoved {
+				removed[h] = struct{}{}
+				changed = true
+			}
+		}
+	}
+
+	for h := range removed {
+		delete(o.payloads, h)
+	}
+	return invalid, len(removed), true
+}
+
+// prune removes the payloads that are more than optimisticPayloadRetention
+// slots older than the given slot.
+func (o *optimisticPayloads) prune(slot math.Slot) {
+	if slot < optimisticPayloadRetention {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for hash, payload := range o.payloads {
+		if payload.slot+optimisticPayloadRetention <= slot {
+			delete(o.payloads, hash)
+		}
+	}
+}
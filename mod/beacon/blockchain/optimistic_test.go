@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// TestOptimisticPayloadsPrune tests that payloads are only tracked for
+// optimisticPayloadRetention slots.
+func TestOptimisticPayloadsPrune(t *testing.T) {
+	o := newOptimisticPayloads()
+	for slot := range math.Slot(2 * optimisticPayloadRetention) {
+		hash := common.ExecutionHash{byte(slot + 1)}
+		o.insert(hash, common.ExecutionHash{byte(slot)}, slot)
+		o.prune(slot)
+	}
+
+	if got := len(o.payloads); got != optimisticPayloadRetention {
+		t.Fatalf(
+			"expected %d tracked payloads, got %d",
+			optimisticPayloadRetention, got,
+		)
+	}
+	for _, payload := range o.payloads {
+		if payload.slot < optimisticPayloadRetention {
+			t.Fatalf("expected slot %d to be pruned", payload.slot)
+		}
+	}
+}
+
+// TestOptimisticPayloadsLastValidHash tests that invalidating a payload does
+// not change the last payload reported as VALID.
+func TestOptimisticPayloadsLastValidHash(t *testing.T) {
+	var (
+		o       = newOptimisticPayloads()
+		valid   = common.ExecutionHash{1}
+		pending = common.ExecutionHash{2}
+		invalid = common.ExecutionHash{3}
+	)
+	if got := o.lastValidHash(); got != (common.ExecutionHash{}) {
+		t.Fatalf("expected the zero hash, got %s", got)
+	}
+
+	o.insert(valid, common.ExecutionHash{}, 1)
+	o.insert(pending, valid, 2)
+	o.insert(invalid, pending, 3)
+	o.markValid(valid)
+
+	if _, numRemoved, ok := o.invalidate(invalid); !ok || numRemoved != 1 {
+		t.Fatalf("expected 1 removed payload, got %d (%t)", numRemoved, ok)
+	}
+	if got := o.lastValidHash(); got != valid {
+		t.Fatalf("expected last valid hash %s, got %s", valid, got)
+	}
+	if _, ok := o.payloads[pending]; !ok {
+		t.Fatal("expected the parent of the invalid payload to be tracked")
+	}
+}
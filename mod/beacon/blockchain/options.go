@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// options holds the optional settings of the blockchain service.
+type options[BeaconBlockBodyT, BeaconStateT any] struct {
+	// daSampler checks data availability by sampling, if set.
+	daSampler DASampler[BeaconBlockBodyT]
+	// epochHooks are called whenever a block starts a new epoch.
+	epochHooks []EpochHook[BeaconStateT]
+	// clock is the clock proposals are checked against, if set.
+	clock Clock
+	// maxTimestampDrift is how far ahead of the clock the execution payload
+	// of a proposal may be timestamped, if set.
+	maxTimestampDrift *time.Duration
+	// slotReprocessing lets blocks for already processed slots be processed
+	// again.
+	slotReprocessing bool
+}
+
+// Option is a functional option for the blockchain service.
+type Option[BeaconBlockBodyT, BeaconStateT any] func(
+	*options[BeaconBlockBodyT, BeaconStateT],
+) error
+
+// WithDASampler sets a sampler that checks the data availability of blocks
+// in place of requiring all of their blob sidecars to be stored.
+func WithDASampler[BeaconBlockBodyT, BeaconStateT any](
+	sampler DASampler[BeaconBlockBodyT],
+) Option[BeaconBlockBodyT, BeaconStateT] {
+	return func(o *options[BeaconBlockBodyT, BeaconStateT]) error {
+		if sampler == nil {
+			return errors.New("data availability sampler must not be nil")
+		}
+		o.daSampler = sampler
+		return nil
+	}
+}
+
+// WithEpochHook registers a hook that is called whenever a processed block
+// starts a new epoch. Hooks are called in the order they are registered.
+func WithEpochHook[BeaconBlockBodyT, BeaconStateT any](
+	hook EpochHook[BeaconStateT],
+) Option[BeaconBlockBodyT, BeaconStateT] {
+	return func(o *options[BeaconBlockBodyT, BeaconStateT]) error {
+		if hook == nil {
+			return errors.New("epoch hook must not be nil")
+		}
+		o.epochHooks = append(o.epochHooks, hook)
+		return nil
+	}
+}
+
+// WithSlotReprocessing lets blocks for slots that have already been
+// processed be processed again, skipping the slot checks. It is meant for
+// tests that intentionally re-validate blocks, and must not be used on live
+// nodes, since a duplicate delivery would then apply a block twice.
+func WithSlotReprocessing[BeaconBlockBodyT, BeaconStateT any]() Option[
+	BeaconBlockBodyT, BeaconStateT,
+] {
+	return func(o *options[BeaconBlockBodyT, BeaconStateT]) error {
+		o.slotReprocessing = true
+		return nil
+	}
+}
+
+// WithClock sets the clock that the payload timestamps of proposals are
+// checked against. It defaults to a SpecClock fed by the genesis time of the
+// service.
+func WithClock[BeaconBlockBodyT, BeaconStateT any](
+	clock Clock,
+) Option[BeaconBlockBodyT, BeaconStateT] {
+	return func(o *options[BeaconBlockBodyT, BeaconStateT]) error {
+		if clock == nil {
+			return errors.New("clock must not be nil")
+		}
+		o.clock = clock
+		return nil
+	}
+}
+
+// WithMaxTimestampDrift sets how far ahead of the clock the execution payload
+// of a proposal may be timestamped before the proposal is rejected. It
+// defaults to the slot duration plus the maximum clock disparity, since
+// proposers timestamp their payloads one slot ahead.
+func WithMaxTimestampDrift[BeaconBlockBodyT, BeaconStateT any](
+	drift time.Duration,
+) Option[BeaconBlockBodyT, BeaconStateT] {
+	return func(o *options[BeaconBlockBodyT, BeaconStateT]) error {
+		if drift < 0 {
+			return errors.Newf(
+				"max timestamp drift must not be negative: %s", drift,
+			)
+		}
+		o.maxTimestampDrift = &drift
+		return nil
+	}
+}
@@ -114,7 +114,6 @@ func (s *Service[
 	st BeaconStateT,
 ) error {
 	var (
-		prevStateRoot common.Root
 		prevBlockRoot common.Root
 		lph           ExecutionPayloadHeaderT
 		slot          math.Slot
@@ -136,13 +135,7 @@ func (s *Service[
 		return err
 	}
 
-	prevStateRoot, err = st.HashTreeRoot()
-	if err != nil {
-		return err
-	}
-
-	latestHeader.SetStateRoot(prevStateRoot)
-	prevBlockRoot, err = latestHeader.HashTreeRoot()
+	prevBlockRoot, err = s.latestBlockRoot(st, latestHeader)
 	if err != nil {
 		return err
 	}
@@ -257,7 +250,7 @@ func (s *Service[
 		// TODO: this is hood as fuck.
 		max(
 			//#nosec:G701
-			uint64(time.Now().Unix()+int64(s.cs.TargetSecondsPerEth1Block())),
+			uint64(time.Now().Unix()+int64(s.cs.SecondsPerSlot())),
 			uint64((payload.GetTimestamp()+1)),
 		),
 		// The previous block root is simply the root of the block we just
@@ -25,14 +25,22 @@ import (
 	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"golang.org/x/sync/errgroup"
 )
 
 // ProcessGenesisData processes the genesis state and initializes the beacon
-// state.
+// state. The genesis fork version must match the fork that the chain spec
+// activates at slot 0, and the genesis time must not precede the minimum
+// genesis time of the chain spec. Once the state is initialized, the genesis
+// time it records is cached, and the execution client is pointed at the
+// genesis execution payload.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -48,12 +56,55 @@ func (s *Service[
 	ctx context.Context,
 	genesisData GenesisT,
 ) ([]*transition.ValidatorUpdate, error) {
-	return s.sp.InitializePreminedBeaconStateFromEth1(
-		s.sb.StateFromContext(ctx),
+	var (
+		forkVersion     = genesisData.GetForkVersion()
+		expectedVersion = version.FromUint32[common.Version](
+			s.cs.ActiveForkVersionForSlot(0),
+		)
+	)
+	if forkVersion != expectedVersion {
+		return nil, errors.Wrapf(
+			ErrGenesisForkVersionMismatch,
+			"expected %s, got %s",
+			expectedVersion, forkVersion,
+		)
+	}
+
+	header := genesisData.GetExecutionPayloadHeader()
+	if err := verifyGenesisTime(
+		s.cs, header.GetTimestamp().Unwrap(),
+	); err != nil {
+		return nil, err
+	}
+
+	st := s.sb.StateFromContext(ctx)
+	valUpdates, err := s.sp.InitializePreminedBeaconStateFromEth1(
+		st,
 		genesisData.GetDeposits(),
-		genesisData.GetExecutionPayloadHeader(),
-		genesisData.GetForkVersion(),
+		header,
+		forkVersion,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheGenesisTime(st)
+	go s.sendGenesisFCU(ctx, header)
+	return valUpdates, nil
+}
+
+// verifyGenesisTime checks that the given genesis time does not precede the
+// minimum genesis time of the chain spec, if one is set. Local networks start
+// at a genesis time of zero.
+func verifyGenesisTime(cs common.ChainSpec, genesisTime uint64) error {
+	if genesisTime < cs.MinGenesisTime() {
+		return errors.Wrapf(
+			ErrInvalidGenesisTime,
+			"genesis time: %d, minimum genesis time: %d",
+			genesisTime, cs.MinGenesisTime(),
+		)
+	}
+	return nil
 }
 
 // ProcessBlockAndBlobs receives an incoming beacon block, it first validates
@@ -73,6 +124,51 @@ func (s *Service[
 	ctx context.Context,
 	blk BeaconBlockT,
 	sidecars BlobSidecarsT,
+) ([]*transition.ValidatorUpdate, error) {
+	return s.processBlockAndBlobs(ctx, blk, sidecars, true)
+}
+
+// ProcessBlockAndBlobsWithoutFCU processes the given block and its blobs like
+// ProcessBlockAndBlobs, but without sending a forkchoice update to the
+// execution client afterwards. It is meant for importing a batch of blocks,
+// which should be followed by a single call to ForceFCU.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) ProcessBlockAndBlobsWithoutFCU(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+) ([]*transition.ValidatorUpdate, error) {
+	return s.processBlockAndBlobs(ctx, blk, sidecars, false)
+}
+
+// processBlockAndBlobs processes the given block and its blobs. If sendFCU is
+// set, a forkchoice update is sent once the block is processed.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) processBlockAndBlobs(
+	ctx context.Context,
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+	sendFCU bool,
 ) ([]*transition.ValidatorUpdate, error) {
 	var (
 		g, gCtx    = errgroup.WithContext(ctx)
@@ -80,41 +176,102 @@ func (s *Service[
 		valUpdates []*transition.ValidatorUpdate
 	)
 
-	// If the block is nil, exit early.
+	// If the block or its body is nil, exit early.
 	if blk.IsNil() {
 		return nil, ErrNilBlk
+	} else if blk.GetBody().IsNil() {
+		return nil, ErrNilBlkBody
 	}
+	s.cacheGenesisTime(st)
 
-	// Launch a goroutine to process the incoming beacon block.
-	g.Go(func() error {
-		var err error
-		// We set `OptimisticEngine` to true since this is called during
-		// FinalizeBlock. We want to assume the payload is valid. If it
-		// ends up not being valid later, the node will simply AppHash,
-		// which is completely fine. This means we were syncing from a
-		// bad peer, and we would likely AppHash anyways.
-		valUpdates, err = s.processBeaconBlock(gCtx, st, blk)
-		return err
-	})
+	// Reject blocks for slots that have already been processed, so that a
+	// duplicate delivery cannot apply the block twice. Blocks can still be
+	// re-validated against the current state with ValidateBlockDryRun, or
+	// reprocessed by services built WithSlotReprocessing.
+	latestSlot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	reprocessed := s.slotReprocessing && blk.GetSlot() <= latestSlot
+	if !reprocessed {
+		if err = verifySlotNotProcessed(blk.GetSlot(), latestSlot); err != nil {
+			return nil, err
+		}
 
-	// Launch a goroutine to process the blob sidecars.
-	g.Go(func() error {
-		return s.processBlobSidecars(gCtx, blk.GetSlot(), sidecars)
-	})
+		// Make sure no block was lost on delivery since the latest one.
+		if err = s.verifySlotContinuity(st, blk, latestSlot); err != nil {
+			return nil, err
+		}
+	}
 
-	// Wait for the goroutines to finish.
-	if err := g.Wait(); err != nil {
+	// Make sure the block was received along with its blobs, so that a
+	// proposer omitting them is reported as such.
+	if err = s.verifySidecarsPresence(blk.GetBody(), sidecars); err != nil {
 		return nil, err
 	}
 
+	// Blobs were introduced in Deneb, so blocks of earlier forks have none
+	// to process.
+	hasBlobs := s.cs.ActiveForkVersionForSlot(blk.GetSlot()) >= version.Deneb
+
+	// When debugging, process the block and then its blobs on this
+	// goroutine, so that logs and stack traces are deterministic.
+	if s.cfg.SequentialValidation {
+		valUpdates, err = s.processBeaconBlock(ctx, st, blk)
+		if err != nil {
+			return nil, err
+		}
+		if hasBlobs {
+			if err = s.processBlobSidecars(
+				ctx, blk.GetSlot(), sidecars,
+			); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// Launch a goroutine to process the incoming beacon block.
+		g.Go(func() error {
+			var blkErr error
+			valUpdates, blkErr = s.processBeaconBlock(gCtx, st, blk)
+			return blkErr
+		})
+
+		// Launch a goroutine to process the blob sidecars.
+		if hasBlobs {
+			g.Go(func() error {
+				return s.processBlobSidecars(gCtx, blk.GetSlot(), sidecars)
+			})
+		}
+
+		// Wait for the goroutines to finish.
+		if err = g.Wait(); err != nil {
+			return nil, err
+		}
+	}
+
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
-	if !s.sb.AvailabilityStore(ctx).IsDataAvailable(
-		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
+	if !s.isDataAvailable(ctx, blk.GetSlot(), blk.GetBody()) {
 		return nil, ErrDataNotAvailable
 	}
+	s.metrics.markBlockProcessed(blk.GetSlot())
+
+	// Let the registered hooks react to the start of a new epoch.
+	epoch := s.cs.SlotToEpoch(blk.GetSlot())
+	if epoch > s.cs.SlotToEpoch(latestSlot) {
+		runEpochHooks(ctx, s.epochHooks, epoch, st)
+	}
+
+	// In optimistic mode the payload may have been imported without being
+	// validated by the execution client, so we track it until it is.
+	if s.cfg.OptimisticModeEnabled {
+		payload := blk.GetBody().GetExecutionPayload()
+		s.optimisticPayloads.insert(
+			payload.GetBlockHash(), payload.GetParentHash(), blk.GetSlot(),
+		)
+	}
+	s.optimisticPayloads.prune(blk.GetSlot())
 
 	// If required, we want to forkchoice at the end of post
 	// block processing.
@@ -125,7 +282,27 @@ func (s *Service[
 		s.blockFeed.Send(
 			asynctypes.NewEvent(ctx, events.BeaconBlockFinalized, blk),
 		)
-		s.sendPostBlockFCU(ctx, st, blk)
+		var status *engineprimitives.PayloadStatusV1
+		if sendFCU {
+			status = s.sendPostBlockFCU(ctx, st, blk)
+		}
+		headHash := blk.GetBody().GetExecutionPayload().GetBlockHash()
+		event, ok := newProcessedBlockEvent(blk, headHash, sendFCU, status)
+		if !ok {
+			s.logger.Warn(
+				"Not emitting processed block event for INVALID head",
+				"slot", blk.GetSlot().Base10(),
+				"head_block_hash", headHash,
+			)
+			return
+		}
+		if dropped := s.processedBlockSubs.send(event); dropped > 0 {
+			s.logger.Warn(
+				"Dropped processed block event for slow subscribers",
+				"slot", blk.GetSlot().Base10(),
+				"num_dropped", dropped,
+			)
+		}
 	}()
 
 	return valUpdates, nil
@@ -152,8 +329,16 @@ func (s *Service[
 	defer s.metrics.measureStateTransitionDuration(startTime)
 	valUpdates, err := s.sp.Transition(
 		&transition.Context{
-			Context:          ctx,
-			OptimisticEngine: true,
+			Context: ctx,
+			// When optimistic mode is enabled, we want to assume the payload
+			// is valid since this is called during FinalizeBlock. If it
+			// ends up not being valid later, the node will simply AppHash,
+			// which is completely fine. This means we were syncing from a
+			// bad peer, and we would likely AppHash anyways.
+			//
+			// When it is disabled, a SYNCING or ACCEPTED response from the
+			// execution client causes the block to be refused.
+			OptimisticEngine: s.cfg.OptimisticModeEnabled,
 			// When we are NOT synced to the tip, process proposal
 			// does NOT get called and thus we must ensure that
 			// NewPayload is called to get the execution
@@ -168,11 +353,12 @@ func (s *Service[
 			// the "verification aspect" of this NewPayload call is
 			// actually irrelevant at this point.
 			SkipPayloadVerification: false,
+			SequentialValidation:    s.cfg.SequentialValidation,
 		},
 		st,
 		blk,
 	)
-	return valUpdates, err
+	return valUpdates, wrapPayloadErr(err)
 }
 
 // ProcessBlobSidecars processes the blob sidecars.
@@ -194,9 +380,165 @@ func (s *Service[
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureBlobProcessingDuration(startTime)
-	return s.bp.ProcessBlobs(
+	if err := s.bp.ProcessBlobs(
 		slot,
 		s.sb.AvailabilityStore(ctx),
 		sidecars,
+	); err != nil {
+		return err
+	}
+
+	s.metrics.markBlobsProcessed(sidecars.Len())
+	s.logger.Debug(
+		"Processed blob sidecars",
+		"slot", slot.Base10(),
+		"num_blobs", sidecars.Len(),
+		"duration", time.Since(startTime).String(),
+	)
+	return nil
+}
+
+// isDataAvailable reports whether the blob data of the block body is
+// available, delegating to the data availability sampler if one is set and
+// requiring all sidecars to be stored otherwise.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) isDataAvailable(
+	ctx context.Context,
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) bool {
+	if s.daSampler != nil {
+		return s.daSampler.IsDataAvailable(ctx, slot, body)
+	}
+	return s.sb.AvailabilityStore(ctx).IsDataAvailable(ctx, slot, body)
+}
+
+// verifySidecarsPresence checks that blob sidecars were received if and only
+// if the block body declares blob commitments. Missing sidecars are allowed
+// when a DA sampler is set, since the node then does not store all blobs.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) verifySidecarsPresence(
+	body BeaconBlockBodyT,
+	sidecars BlobSidecarsT,
+) error {
+	var (
+		numCommitments = len(body.GetBlobKzgCommitments())
+		numSidecars    int
+	)
+	if !sidecars.IsNil() {
+		numSidecars = sidecars.Len()
+	}
+
+	switch {
+	case numCommitments > 0 && numSidecars == 0 && s.daSampler == nil:
+		return errors.Wrapf(
+			ErrMissingBlobSidecars,
+			"expected %d sidecars", numCommitments,
+		)
+	case numCommitments == 0 && numSidecars > 0:
+		return errors.Wrapf(
+			ErrUnexpectedBlobSidecars,
+			"received %d sidecars", numSidecars,
+		)
+	default:
+		return nil
+	}
+}
+
+// runEpochHooks calls the given hooks for the given epoch, in order, each
+// with its own copy of the given state. The hooks run before the next block
+// is processed, since a copy of the state reads through to the live store and
+// would otherwise observe the writes of later blocks.
+func runEpochHooks[BeaconStateT interface{ Copy() BeaconStateT }](
+	ctx context.Context,
+	hooks []EpochHook[BeaconStateT],
+	epoch math.Epoch,
+	st BeaconStateT,
+) {
+	for _, hook := range hooks {
+		hook(ctx, epoch, st.Copy())
+	}
+}
+
+// verifySlotNotProcessed returns ErrSlotAlreadyProcessed if a block for the
+// given slot was already processed, given the latest processed slot.
+func verifySlotNotProcessed(slot, latestSlot math.Slot) error {
+	if slot <= latestSlot {
+		return errors.Wrapf(
+			ErrSlotAlreadyProcessed,
+			"block slot: %d, latest processed slot: %d",
+			slot, latestSlot,
+		)
+	}
+	return nil
+}
+
+// verifySlotContinuity checks that the given block directly follows the
+// latest processed block, whose slot is given. A block may only skip slots
+// that were left empty, in which case it must build on the latest processed
+// block, otherwise ErrSlotGap is returned.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) verifySlotContinuity(
+	st BeaconStateT,
+	blk BeaconBlockT,
+	latestSlot math.Slot,
+) error {
+	expectedSlot := latestSlot + 1
+	if blk.GetSlot() == expectedSlot {
+		return nil
+	}
+
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return err
+	}
+	latestRoot, err := s.latestBlockRoot(st, header)
+	if err != nil {
+		return err
+	}
+	if blk.GetParentBlockRoot() == latestRoot {
+		return nil
+	}
+
+	s.logger.Error(
+		"Gap detected between processed blocks",
+		"expected_slot", expectedSlot.Base10(),
+		"actual_slot", blk.GetSlot().Base10(),
+		"parent_block_root", blk.GetParentBlockRoot(),
+		"latest_block_root", latestRoot,
+	)
+	return errors.Wrapf(
+		ErrSlotGap,
+		"expected slot: %d, block slot: %d", expectedSlot, blk.GetSlot(),
 	)
 }
@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BenchmarkProcessBeaconBlock measures importing a block with N withdrawals
+// and M blobs. The execution engine and the beacon state are stubs, so only
+// the work of the service itself and the hashing of the withdrawals by the
+// stub state processor are measured.
+func BenchmarkProcessBeaconBlock(b *testing.B) {
+	for _, numWithdrawals := range []int{0, 16} {
+		for _, numBlobs := range []int{0, 3, 6} {
+			b.Run(
+				fmt.Sprintf("withdrawals=%d/blobs=%d", numWithdrawals, numBlobs),
+				func(b *testing.B) {
+					benchmarkProcessBeaconBlock(b, numWithdrawals, numBlobs)
+				},
+			)
+		}
+	}
+}
+
+func benchmarkProcessBeaconBlock(
+	b *testing.B, numWithdrawals, numBlobs int,
+) {
+	var (
+		cfg = DefaultConfig()
+		cs  = chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType, math.Epoch, common.ExecutionAddress,
+				math.Slot, any,
+			]{
+				SlotsPerEpoch: 32,
+			},
+		)
+		st  = &benchState{slot: 0}
+		avs = &benchAvailabilityStore{blobs: make(map[math.Slot]int)}
+	)
+	s, err := NewService[
+		*benchAvailabilityStore, *benchBlock, *benchBody, *benchHeader,
+		*benchState, *benchSidecars, benchDeposit, *benchPayload,
+		*benchPayload, *benchGenesis,
+	](
+		&benchStorageBackend{st: st, avs: avs},
+		noop.NewLogger(),
+		&cfg,
+		cs,
+		benchEngine{},
+		benchLocalBuilder{},
+		benchBlobProcessor{},
+		benchStateProcessor{},
+		benchTelemetrySink{},
+		benchFeed{},
+		false,
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	blk := newBenchBlock(1, numWithdrawals, numBlobs)
+	sidecars := &benchSidecars{len: numBlobs}
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err = s.ProcessBlockAndBlobsWithoutFCU(
+			ctx, blk, sidecars,
+		); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// newBenchBlock returns a block for the given slot whose payload carries the
+// given number of withdrawals, and whose body carries the given number of
+// blob commitments.
+func newBenchBlock(slot math.Slot, numWithdrawals, numBlobs int) *benchBlock {
+	withdrawals := make([]*engineprimitives.Withdrawal, numWithdrawals)
+	for i := range withdrawals {
+		withdrawals[i] = &engineprimitives.Withdrawal{
+			Index:     math.U64(i),
+			Validator: math.ValidatorIndex(i),
+			Amount:    math.Gwei(i),
+		}
+	}
+	return &benchBlock{
+		slot: slot,
+		body: &benchBody{
+			payload: &benchPayload{
+				number:      math.U64(slot),
+				withdrawals: withdrawals,
+			},
+			commitments: make(benchCommitments, numBlobs),
+		},
+	}
+}
+
+// benchSSZ implements ssz.Marshallable for the stub types.
+type benchSSZ struct{}
+
+func (benchSSZ) MarshalSSZTo(buf []byte) ([]byte, error) { return buf, nil }
+func (benchSSZ) MarshalSSZ() ([]byte, error)             { return nil, nil }
+func (benchSSZ) UnmarshalSSZ([]byte) error               { return nil }
+func (benchSSZ) SizeSSZ() int                            { return 0 }
+func (benchSSZ) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+type benchPayload struct {
+	number      math.U64
+	withdrawals []*engineprimitives.Withdrawal
+}
+
+func (p *benchPayload) Empty(uint32) *benchPayload { return &benchPayload{} }
+func (p *benchPayload) IsNil() bool                { return p == nil }
+func (p *benchPayload) Version() uint32            { return 0 }
+func (p *benchPayload) GetTimestamp() math.U64     { return p.number }
+func (p *benchPayload) GetBlockHash() common.ExecutionHash {
+	return common.ExecutionHash{byte(p.number)}
+}
+func (p *benchPayload) GetParentHash() common.ExecutionHash {
+	return common.ExecutionHash{byte(p.number - 1)}
+}
+func (p *benchPayload) GetPrevRandao() common.Bytes32 {
+	return common.Bytes32{}
+}
+func (p *benchPayload) GetNumber() math.U64        { return p.number }
+func (p *benchPayload) GetGasLimit() math.U64      { return 0 }
+func (p *benchPayload) GetGasUsed() math.U64       { return 0 }
+func (p *benchPayload) GetExtraData() []byte       { return nil }
+func (p *benchPayload) GetBaseFeePerGas() math.Wei { return math.Wei{} }
+func (p *benchPayload) GetFeeRecipient() common.ExecutionAddress {
+	return common.ExecutionAddress{}
+}
+func (p *benchPayload) GetStateRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+func (p *benchPayload) GetReceiptsRoot() common.Bytes32 {
+	return common.Bytes32{}
+}
+func (p *benchPayload) GetLogsBloom() []byte       { return nil }
+func (p *benchPayload) GetBlobGasUsed() math.U64   { return 0 }
+func (p *benchPayload) GetExcessBlobGas() math.U64 { return 0 }
+func (p *benchPayload) GetWithdrawals() []*engineprimitives.Withdrawal {
+	return p.withdrawals
+}
+func (p *benchPayload) GetTransactions() [][]byte { return nil }
+
+type benchCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+type benchBody struct {
+	benchSSZ
+	payload     *benchPayload
+	commitments benchCommitments
+}
+
+func (b *benchBody) IsNil() bool                        { return b == nil }
+func (b *benchBody) GetExecutionPayload() *benchPayload { return b.payload }
+func (b *benchBody) GetBlobKzgCommitments() benchCommitments {
+	return b.commitments
+}
+
+type benchBlock struct {
+	benchSSZ
+	slot math.Slot
+	body *benchBody
+}
+
+func (b *benchBlock) IsNil() bool                     { return b == nil }
+func (b *benchBlock) GetSlot() math.Slot              { return b.slot }
+func (b *benchBlock) GetParentBlockRoot() common.Root { return common.Root{} }
+func (b *benchBlock) GetStateRoot() common.Root       { return common.Root{} }
+func (b *benchBlock) GetBody() *benchBody             { return b.body }
+
+type benchHeader struct {
+	benchSSZ
+	slot      math.Slot
+	stateRoot common.Root
+}
+
+func (h *benchHeader) GetSlot() math.Slot            { return h.slot }
+func (h *benchHeader) GetStateRoot() common.Root     { return h.stateRoot }
+func (h *benchHeader) SetStateRoot(root common.Root) { h.stateRoot = root }
+
+// benchState is a beacon state stub that stays at the given slot.
+type benchState struct {
+	slot math.Slot
+}
+
+func (s *benchState) Copy() *benchState { return &benchState{slot: s.slot} }
+func (s *benchState) GetLatestBlockHeader() (*benchHeader, error) {
+	return &benchHeader{slot: s.slot}, nil
+}
+func (s *benchState) GetLatestExecutionPayloadHeader() (*benchPayload, error) {
+	return &benchPayload{number: math.U64(s.slot)}, nil
+}
+func (s *benchState) GetSlot() (math.Slot, error)     { return s.slot, nil }
+func (s *benchState) GetGenesisTime() (uint64, error) { return 1, nil }
+func (s *benchState) HashTreeRoot() ([32]byte, error) { return [32]byte{}, nil }
+
+type benchSidecars struct {
+	benchSSZ
+	len int
+}
+
+func (s *benchSidecars) IsNil() bool { return s == nil }
+func (s *benchSidecars) Len() int    { return s.len }
+
+// benchAvailabilityStore records the number of blobs stored per slot.
+type benchAvailabilityStore struct {
+	mu    sync.Mutex
+	blobs map[math.Slot]int
+}
+
+func (a *benchAvailabilityStore) IsDataAvailable(
+	_ context.Context, slot math.Slot, body *benchBody,
+) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.blobs[slot] == len(body.GetBlobKzgCommitments())
+}
+
+type benchStorageBackend struct {
+	st  *benchState
+	avs *benchAvailabilityStore
+}
+
+func (sb *benchStorageBackend) AvailabilityStore(
+	context.Context,
+) *benchAvailabilityStore {
+	return sb.avs
+}
+
+func (sb *benchStorageBackend) StateFromContext(
+	context.Context,
+) *benchState {
+	return sb.st
+}
+
+type benchBlobProcessor struct{}
+
+func (benchBlobProcessor) ProcessBlobs(
+	slot math.Slot, avs *benchAvailabilityStore, sidecars *benchSidecars,
+) error {
+	avs.mu.Lock()
+	defer avs.mu.Unlock()
+	avs.blobs[slot] = sidecars.Len()
+	return nil
+}
+
+func (benchBlobProcessor) VerifyBlobs(math.Slot, *benchSidecars) error {
+	return nil
+}
+
+// benchStateProcessor stands in for the state transition by hashing the
+// withdrawals of the block payload.
+type benchStateProcessor struct{}
+
+func (benchStateProcessor) InitializePreminedBeaconStateFromEth1(
+	*benchState, []benchDeposit, *benchPayload, common.Version,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (benchStateProcessor) ProcessSlots(
+	*benchState, math.Slot,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (benchStateProcessor) Transition(
+	_ *transition.Context, _ *benchState, blk *benchBlock,
+) ([]*transition.ValidatorUpdate, error) {
+	_, err := engineprimitives.Withdrawals(
+		blk.GetBody().GetExecutionPayload().GetWithdrawals(),
+	).HashTreeRoot()
+	return nil, err
+}
+
+type benchEngine struct{}
+
+func (benchEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*benchPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	return nil
+}
+
+func (benchEngine) NotifyForkchoiceUpdate(
+	context.Context, *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	return nil, nil, nil
+}
+
+type benchLocalBuilder struct{}
+
+func (benchLocalBuilder) Enabled() bool { return false }
+
+func (benchLocalBuilder) RequestPayloadAsync(
+	context.Context, *benchState, math.Slot, uint64,
+	common.Root, common.ExecutionHash, common.ExecutionHash,
+) (*engineprimitives.PayloadID, error) {
+	return nil, nil
+}
+
+func (benchLocalBuilder) SendForceHeadFCU(
+	context.Context, *benchState, math.Slot,
+) error {
+	return nil
+}
+
+type benchTelemetrySink struct{}
+
+func (benchTelemetrySink) IncrementCounter(string, ...string)        {}
+func (benchTelemetrySink) AddCounter(string, uint64, ...string)      {}
+func (benchTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+func (benchTelemetrySink) SetGauge(string, int64, ...string)         {}
+
+type benchFeed struct{}
+
+func (benchFeed) Send(*asynctypes.Event[*benchBlock]) int { return 0 }
+
+type benchDeposit struct{}
+
+type benchGenesis struct{}
+
+func (*benchGenesis) GetForkVersion() common.Version { return common.Version{} }
+func (*benchGenesis) GetDeposits() []benchDeposit    { return nil }
+func (*benchGenesis) GetExecutionPayloadHeader() *benchPayload {
+	return &benchPayload{}
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// TestVerifySlotNotProcessed tests that blocks are rejected for slots up to
+// and including the latest processed slot.
+func TestVerifySlotNotProcessed(t *testing.T) {
+	if err := verifySlotNotProcessed(11, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, slot := range []math.Slot{9, 10} {
+		err := verifySlotNotProcessed(slot, 10)
+		if !errors.Is(err, ErrSlotAlreadyProcessed) {
+			t.Fatalf(
+				"slot %d: expected %v, got %v",
+				slot, ErrSlotAlreadyProcessed, err,
+			)
+		}
+	}
+}
+
+// TestVerifyGenesisTime tests that the genesis time must not precede the
+// minimum genesis time of the chain spec, if one is set.
+func TestVerifyGenesisTime(t *testing.T) {
+	unset := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{},
+	)
+	for _, genesisTime := range []uint64{0, 1_000} {
+		if err := verifyGenesisTime(unset, genesisTime); err != nil {
+			t.Fatalf("genesis time %d: unexpected error: %v", genesisTime, err)
+		}
+	}
+
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			MinGenesisTime: 1_000,
+		},
+	)
+
+	for _, genesisTime := range []uint64{1_000, 1_001} {
+		if err := verifyGenesisTime(cs, genesisTime); err != nil {
+			t.Fatalf("genesis time %d: unexpected error: %v", genesisTime, err)
+		}
+	}
+	for _, genesisTime := range []uint64{0, 999} {
+		err := verifyGenesisTime(cs, genesisTime)
+		if !errors.Is(err, ErrInvalidGenesisTime) {
+			t.Fatalf(
+				"genesis time %d: expected %v, got %v",
+				genesisTime, ErrInvalidGenesisTime, err,
+			)
+		}
+	}
+}
+
+// copyCountingState is a state that counts how often it was copied.
+type copyCountingState struct {
+	copies *int
+}
+
+func (s *copyCountingState) Copy() *copyCountingState {
+	*s.copies++
+	return &copyCountingState{copies: s.copies}
+}
+
+// TestRunEpochHooks tests that epoch hooks have all run by the time
+// runEpochHooks returns, each on its own copy of the state.
+func TestRunEpochHooks(t *testing.T) {
+	var (
+		st   = &copyCountingState{copies: new(int)}
+		seen []*copyCountingState
+	)
+	hook := func(_ context.Context, epoch math.Epoch, hookSt *copyCountingState) {
+		if epoch != 3 {
+			t.Errorf("expected epoch 3, got %d", epoch)
+		}
+		seen = append(seen, hookSt)
+	}
+
+	runEpochHooks(
+		context.Background(),
+		[]EpochHook[*copyCountingState]{hook, hook},
+		3,
+		st,
+	)
+
+	if len(seen) != 2 {
+		t.Fatalf("expected 2 hook calls, got %d", len(seen))
+	}
+	if seen[0] == st || seen[1] == st || seen[0] == seen[1] {
+		t.Fatal("expected every hook to get its own copy of the state")
+	}
+	if copies := *st.copies; copies != 2 {
+		t.Fatalf("expected 2 copies of the state, got %d", copies)
+	}
+
+	runEpochHooks(context.Background(), nil, 3, st)
+	if copies := *st.copies; copies != 2 {
+		t.Fatal("expected the state not to be copied without hooks")
+	}
+}
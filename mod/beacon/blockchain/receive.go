@@ -48,6 +48,15 @@ func (s *Service[
 	blk BeaconBlockT,
 	blobs BlobSidecarsT,
 ) error {
+	// When debugging, verify the block and then its blobs on this
+	// goroutine, so that logs and stack traces are deterministic.
+	if s.cfg.SequentialValidation {
+		return errors.JoinFatal(
+			s.VerifyIncomingBlock(ctx, blk),
+			s.VerifyIncomingBlobs(ctx, blk, blobs),
+		)
+	}
+
 	var (
 		blockErr, blobsErr error
 		wg                 sync.WaitGroup
@@ -88,6 +97,7 @@ func (s *Service[
 ) error {
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.sb.StateFromContext(ctx)
+	s.cacheGenesisTime(preState)
 
 	// Force a sync of the startup head if we haven't done so already.
 	//
@@ -103,11 +113,37 @@ func (s *Service[
 		return errors.WrapNonFatal(ErrNilBlk)
 	}
 
+	// If the block body is nil, exit early.
+	if blk.GetBody().IsNil() {
+		s.logger.Warn(
+			"Aborting block verification - beacon block body is nil 🚫",
+		)
+		return errors.WrapNonFatal(ErrNilBlkBody)
+	}
+
 	s.logger.Info(
 		"Received incoming beacon block 📫",
 		"state_root", blk.GetStateRoot(),
 	)
 
+	// Reject proposals whose payload is timestamped too far in the future.
+	// This reads the wall clock, so it is only checked here and never when
+	// the block is finalized.
+	if err := verifyPayloadTimestamp(
+		s.clock,
+		s.maxTimestampDrift,
+		blk.GetBody().GetExecutionPayload().GetTimestamp(),
+	); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"state_root",
+			blk.GetStateRoot(),
+			"reason",
+			err,
+		)
+		return err
+	}
+
 	// We purposefully make a copy of the BeaconState in orer
 	// to avoid modifying the underlying state, for the event in which
 	// we have to rebuild a payload for this slot again, if we do not agree
@@ -146,6 +182,55 @@ func (s *Service[
 	return nil
 }
 
+// ValidateBlockDryRun reports whether the given block would be valid on top of
+// the current state, without mutating state or contacting the execution
+// client. The block is applied to a copy of the state and the result is
+// discarded.
+//
+// Compared to VerifyIncomingBlock, the following checks are skipped:
+//   - the NewPayload call to the execution client, and with it the execution
+//     payload's parent hash, prev randao, blob count and withdrawal count
+//     checks performed alongside it.
+//
+// The remaining checks still run: block header (slot, proposer, parent root),
+// withdrawals, randao reveal signature, deposits and the resulting state root.
+// No forced startup sync, optimistic payload build or payload rebuild is
+// triggered.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) ValidateBlockDryRun(
+	ctx context.Context,
+	blk BeaconBlockT,
+) error {
+	if blk.IsNil() {
+		return ErrNilBlk
+	} else if blk.GetBody().IsNil() {
+		return ErrNilBlkBody
+	}
+
+	_, err := s.sp.Transition(
+		&transition.Context{
+			Context:                 ctx,
+			OptimisticEngine:        false,
+			SkipPayloadVerification: true,
+			SkipValidateResult:      false,
+			SkipValidateRandao:      false,
+		},
+		s.sb.StateFromContext(ctx).Copy(),
+		blk,
+	)
+	return wrapPayloadErr(err)
+}
+
 // verifyStateRoot verifies the state root of an incoming block.
 func (s *Service[
 	AvailabilityStoreT,
@@ -174,6 +259,7 @@ func (s *Service[
 			SkipPayloadVerification: false,
 			SkipValidateResult:      false,
 			SkipValidateRandao:      false,
+			SequentialValidation:    s.cfg.SequentialValidation,
 		},
 		st, blk,
 	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
@@ -184,7 +270,7 @@ func (s *Service[
 		// TODO: this is only true because we are assuming SSF.
 		return nil
 	} else if err != nil {
-		return err
+		return wrapPayloadErr(err)
 	}
 
 	return nil
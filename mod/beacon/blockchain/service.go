@@ -23,8 +23,11 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -41,7 +44,7 @@ type Service[
 	],
 	BlobSidecarsT BlobSidecars,
 	DepositT any,
-	ExecutionPayloadT ExecutionPayload,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
 ] struct {
@@ -57,8 +60,9 @@ type Service[
 	logger log.Logger[any]
 	// cs holds the chain specifications.
 	cs common.ChainSpec
-	// ee is the execution engine responsible for processing execution payloads.
-	ee ExecutionEngine
+	// ee notifies the execution client of new payloads and forkchoice
+	// updates.
+	ee EngineNotifier[ExecutionPayloadT]
 	// lb is a local builder for constructing new beacon states.
 	lb LocalBuilder[BeaconStateT]
 	// bp is the blob processor for processing incoming blobs.
@@ -78,14 +82,43 @@ type Service[
 	metrics *chainMetrics
 	// blockFeed is the event feed for new blocks.
 	blockFeed EventFeed[*asynctypes.Event[BeaconBlockT]]
+	// cfg is the configuration for the service.
+	cfg *Config
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// optimisticPayloads tracks the payloads that were imported
+	// optimistically and are not yet known to be valid.
+	optimisticPayloads *optimisticPayloads
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// daSampler checks data availability by sampling, if set. Otherwise all
+	// blob sidecars of a block must be stored.
+	daSampler DASampler[BeaconBlockBodyT]
+	// fcuLogLimiter deduplicates the logs of failed forkchoice updates.
+	fcuLogLimiter *logLimiter
+	// processedBlockSubs are the subscribers to processed block events.
+	processedBlockSubs *processedBlockSubs[BeaconBlockT]
+	// genesisTime caches the genesis time read from the beacon state, or
+	// zero if it has not been read yet.
+	genesisTime atomic.Uint64
+	// blockRoots caches the roots of committed blocks.
+	blockRoots *blockRootCache
+	// epochHooks are called whenever a block starts a new epoch.
+	epochHooks []EpochHook[BeaconStateT]
+	// clock is the clock the payload timestamps of proposals are checked
+	// against.
+	clock Clock
+	// maxTimestampDrift is how far ahead of the clock the execution payload
+	// of a proposal may be timestamped.
+	maxTimestampDrift time.Duration
+	// slotReprocessing lets blocks for already processed slots be processed
+	// again, for tests that intentionally re-validate blocks.
+	slotReprocessing bool
 }
 
-// NewService creates a new validator service.
+// NewService creates a new validator service. It fails if an option is
+// invalid.
 func NewService[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT, ExecutionPayloadT],
@@ -97,7 +130,7 @@ func NewService[
 	],
 	BlobSidecarsT BlobSidecars,
 	DepositT any,
-	ExecutionPayloadT ExecutionPayload,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
 ](
@@ -108,8 +141,9 @@ func NewService[
 		BlobSidecarsT,
 	],
 	logger log.Logger[any],
+	cfg *Config,
 	cs common.ChainSpec,
-	ee ExecutionEngine,
+	ee EngineNotifier[ExecutionPayloadT],
 	lb LocalBuilder[BeaconStateT],
 	bp BlobProcessor[
 		AvailabilityStoreT, BeaconBlockBodyT, BlobSidecarsT, ExecutionPayloadT,
@@ -125,18 +159,27 @@ func NewService[
 	ts TelemetrySink,
 	blockFeed EventFeed[*asynctypes.Event[BeaconBlockT]],
 	optimisticPayloadBuilds bool,
-) *Service[
+	opts ...Option[BeaconBlockBodyT, BeaconStateT],
+) (*Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BlobSidecarsT, DepositT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, GenesisT,
-] {
-	return &Service[
+], error) {
+	o := &options[BeaconBlockBodyT, BeaconStateT]{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, errors.Wrap(err, "failed to apply option")
+		}
+	}
+
+	s := &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BlobSidecarsT, DepositT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT, GenesisT,
 	]{
 		sb:                      sb,
 		logger:                  logger,
+		cfg:                     cfg,
 		cs:                      cs,
 		ee:                      ee,
 		lb:                      lb,
@@ -145,8 +188,27 @@ func NewService[
 		metrics:                 newChainMetrics(ts),
 		blockFeed:               blockFeed,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		optimisticPayloads:      newOptimisticPayloads(),
 		forceStartupSyncOnce:    new(sync.Once),
+		daSampler:               o.daSampler,
+		fcuLogLimiter:           newLogLimiter(fcuLogCooldown),
+		processedBlockSubs:      newProcessedBlockSubs[BeaconBlockT](),
+		blockRoots:              newBlockRootCache(),
+		epochHooks:              o.epochHooks,
+		clock:                   o.clock,
+		slotReprocessing:        o.slotReprocessing,
+		//#nosec:G701 // the slot duration will never exceed int64 max.
+		maxTimestampDrift: time.Duration(
+			cs.SecondsPerSlot(),
+		)*time.Second + maximumClockDisparity,
+	}
+	if s.clock == nil {
+		s.clock = NewSpecClock(cs, s)
+	}
+	if o.maxTimestampDrift != nil {
+		s.maxTimestampDrift = *o.maxTimestampDrift
 	}
+	return s, nil
 }
 
 // Name returns the name of the service.
@@ -181,3 +243,92 @@ func (s *Service[
 ) error {
 	return nil
 }
+
+// Metrics returns the block processing metrics of the service. It is safe to
+// call concurrently with block processing.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) Metrics() ProcessingMetrics {
+	return s.metrics.snapshot()
+}
+
+// SubscribeProcessedBlocks subscribes to the blocks processed by the service.
+// An event is emitted once a block has been processed and the execution
+// client has been notified of the resulting head. Events are dropped if the
+// subscriber falls behind, and are not guaranteed to arrive in slot order.
+// The returned function unsubscribes and closes the channel.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) SubscribeProcessedBlocks() (
+	<-chan ProcessedBlockEvent[BeaconBlockT], func(),
+) {
+	return s.processedBlockSubs.subscribe()
+}
+
+// GenesisTime returns the genesis time of the chain as a unix timestamp in
+// seconds, i.e. the timestamp of the genesis execution payload. It is read
+// from the beacon state once, when the service first processes genesis data,
+// a block or a proposal, and cached. It returns ErrGenesisTimeNotSet before
+// then.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) GenesisTime() (uint64, error) {
+	genesisTime := s.genesisTime.Load()
+	if genesisTime == 0 {
+		return 0, ErrGenesisTimeNotSet
+	}
+	return genesisTime, nil
+}
+
+// cacheGenesisTime reads the genesis time from the given state, unless it is
+// already cached. States initialized before the genesis time was recorded
+// carry none, in which case it stays unset.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	ExecutionPayloadT,
+	ExecutionPayloadHeaderT,
+	GenesisT,
+]) cacheGenesisTime(st BeaconStateT) {
+	if s.genesisTime.Load() != 0 {
+		return
+	}
+	genesisTime, err := st.GetGenesisTime()
+	if err != nil {
+		s.logger.Debug("Genesis time not found in state", "error", err)
+		return
+	}
+	s.genesisTime.Store(genesisTime)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// processedBlockBufferSize is the number of processed block events buffered
+// for each subscriber. Events for a subscriber whose buffer is full are
+// dropped rather than stalling block processing.
+const processedBlockBufferSize = 16
+
+// ProcessedBlockEvent is emitted once a block has been processed and, unless
+// it was processed without a forkchoice update, the execution client has
+// been notified of the resulting head.
+type ProcessedBlockEvent[BeaconBlockT any] struct {
+	// Block is the processed block.
+	Block BeaconBlockT
+	// HeadHash is the execution block hash of the resulting head.
+	HeadHash common.ExecutionHash
+	// HeadNotified reports whether the execution client was sent a
+	// forkchoice update for HeadHash. It is false for blocks processed with
+	// ProcessBlockAndBlobsWithoutFCU, whose head is only notified by the
+	// ForceFCU call that follows the batch.
+	HeadNotified bool
+}
+
+// newProcessedBlockEvent returns the event emitted for a processed block
+// whose resulting head has the given hash, given whether the execution client
+// was notified of the head and the payload status it reported, if known. It
+// returns false if the execution client reported the head as INVALID, since
+// the head was then dropped in favor of its parent.
+func newProcessedBlockEvent[BeaconBlockT any](
+	blk BeaconBlockT,
+	headHash common.ExecutionHash,
+	notified bool,
+	status *engineprimitives.PayloadStatusV1,
+) (ProcessedBlockEvent[BeaconBlockT], bool) {
+	if status != nil &&
+		status.Status == engineprimitives.PayloadStatusInvalid {
+		return ProcessedBlockEvent[BeaconBlockT]{}, false
+	}
+	return ProcessedBlockEvent[BeaconBlockT]{
+		Block:        blk,
+		HeadHash:     headHash,
+		HeadNotified: notified,
+	}, true
+}
+
+// processedBlockSubs is the set of subscribers to processed block events.
+type processedBlockSubs[BeaconBlockT any] struct {
+	mu     sync.RWMutex
+	nextID uint64
+	subs   map[uint64]chan ProcessedBlockEvent[BeaconBlockT]
+}
+
+// newProcessedBlockSubs creates a new, empty set of subscribers.
+func newProcessedBlockSubs[
+	BeaconBlockT any,
+]() *processedBlockSubs[BeaconBlockT] {
+	return &processedBlockSubs[BeaconBlockT]{
+		subs: make(map[uint64]chan ProcessedBlockEvent[BeaconBlockT]),
+	}
+}
+
+// subscribe adds a subscriber and returns its channel along with a function
+// that removes the subscriber and closes the channel.
+func (p *processedBlockSubs[BeaconBlockT]) subscribe() (
+	<-chan ProcessedBlockEvent[BeaconBlockT], func(),
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := p.nextID
+	p.nextID++
+	ch := make(
+		chan ProcessedBlockEvent[BeaconBlockT], processedBlockBufferSize,
+	)
+	p.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			delete(p.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// send delivers the event to all subscribers without blocking, and returns
+// the number of subscribers the event was dropped for.
+func (p *processedBlockSubs[BeaconBlockT]) send(
+	event ProcessedBlockEvent[BeaconBlockT],
+) int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var dropped int
+	for _, ch := range p.subs {
+		select {
+		case ch <- event:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
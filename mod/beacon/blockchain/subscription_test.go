@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package blockchain
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// TestNewProcessedBlockEvent tests that no event is emitted for a head the
+// execution client reported as INVALID.
+func TestNewProcessedBlockEvent(t *testing.T) {
+	headHash := common.ExecutionHash{1}
+	for _, status := range []*engineprimitives.PayloadStatusV1{
+		nil,
+		{Status: engineprimitives.PayloadStatusValid},
+		{Status: engineprimitives.PayloadStatusSyncing},
+	} {
+		event, ok := newProcessedBlockEvent(uint64(7), headHash, true, status)
+		if !ok {
+			t.Fatalf("expected an event for status %v", status)
+		}
+		if event.Block != 7 || event.HeadHash != headHash ||
+			!event.HeadNotified {
+			t.Fatalf("unexpected event: %+v", event)
+		}
+	}
+
+	if _, ok := newProcessedBlockEvent(
+		uint64(7), headHash, true, &engineprimitives.PayloadStatusV1{
+			Status: engineprimitives.PayloadStatusInvalid,
+		},
+	); ok {
+		t.Fatal("expected no event for an INVALID head")
+	}
+}
+
+// TestNewProcessedBlockEventWithoutFCU tests that the event of a block
+// processed without a forkchoice update is marked as such.
+func TestNewProcessedBlockEventWithoutFCU(t *testing.T) {
+	event, ok := newProcessedBlockEvent(
+		uint64(7), common.ExecutionHash{1}, false, nil,
+	)
+	if !ok {
+		t.Fatal("expected an event")
+	}
+	if event.HeadNotified {
+		t.Fatal("expected the head not to be marked as notified")
+	}
+}
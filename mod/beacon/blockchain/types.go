@@ -26,6 +26,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -68,11 +69,18 @@ type BeaconBlockBody[ExecutionPayloadT any] interface {
 	// GetExecutionPayload returns the execution payload of the beacon block
 	// body.
 	GetExecutionPayload() ExecutionPayloadT
+	// GetBlobKzgCommitments returns the KZG commitments of the blobs of the
+	// beacon block body.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 }
 
 // BeaconBlockHeader represents the interface for the beacon block header.
 type BeaconBlockHeader interface {
 	ssz.Marshallable
+	// GetSlot returns the slot of the beacon block header.
+	GetSlot() math.Slot
+	// GetStateRoot returns the state root of the beacon block header.
+	GetStateRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block header.
 	SetStateRoot(common.Root)
 }
@@ -106,8 +114,19 @@ type BlobSidecars interface {
 	Len() int
 }
 
-// ExecutionEngine is the interface for the execution engine.
-type ExecutionEngine interface {
+// EngineNotifier is the narrow view of the execution engine the service
+// depends on, so that tests can inject canned engine responses.
+type EngineNotifier[
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+] interface {
+	// VerifyAndNotifyNewPayload verifies the new payload and notifies the
+	// execution client.
+	VerifyAndNotifyNewPayload(
+		ctx context.Context,
+		req *engineprimitives.NewPayloadRequest[
+			ExecutionPayloadT, *engineprimitives.Withdrawal,
+		],
+	) error
 	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
 	// update.
 	NotifyForkchoiceUpdate(
@@ -116,6 +135,26 @@ type ExecutionEngine interface {
 	) (*engineprimitives.PayloadID, *common.ExecutionHash, error)
 }
 
+// DASampler checks the data availability of a block by sampling a subset of
+// its blob data, rather than requiring every blob sidecar to be stored. It
+// allows light data availability modes to plug in their own sampling
+// strategy.
+type DASampler[BeaconBlockBodyT any] interface {
+	// IsDataAvailable reports whether the blob data referenced by the block
+	// body at the given slot is available with sufficient confidence.
+	IsDataAvailable(
+		ctx context.Context, slot math.Slot, body BeaconBlockBodyT,
+	) bool
+}
+
+// EpochHook is called once the first block of a new epoch is processed, with
+// the new epoch and a copy of the post state of that block, so that changes
+// made by the hook are discarded. Hooks run on the block processing path, so
+// they must return quickly.
+type EpochHook[BeaconStateT any] func(
+	ctx context.Context, epoch math.Epoch, st BeaconStateT,
+)
+
 // EventFeed is a generic interface for sending events.
 type EventFeed[EventT any] interface {
 	// Send sends an event and returns the number of
@@ -124,8 +163,42 @@ type EventFeed[EventT any] interface {
 }
 
 // ExecutionPayload is the interface for the execution payload.
-type ExecutionPayload interface {
+type ExecutionPayload[ExecutionPayloadT any] interface {
 	ExecutionPayloadHeader
+	// Empty returns an empty execution payload for the given fork version.
+	Empty(uint32) ExecutionPayloadT
+	// IsNil checks if the execution payload is nil.
+	IsNil() bool
+	// Version returns the fork version of the execution payload.
+	Version() uint32
+	// GetPrevRandao returns the prev randao.
+	GetPrevRandao() common.Bytes32
+	// GetNumber returns the block number.
+	GetNumber() math.U64
+	// GetGasLimit returns the gas limit.
+	GetGasLimit() math.U64
+	// GetGasUsed returns the gas used.
+	GetGasUsed() math.U64
+	// GetExtraData returns the extra data.
+	GetExtraData() []byte
+	// GetBaseFeePerGas returns the base fee per gas.
+	GetBaseFeePerGas() math.Wei
+	// GetFeeRecipient returns the fee recipient.
+	GetFeeRecipient() common.ExecutionAddress
+	// GetStateRoot returns the state root.
+	GetStateRoot() common.Bytes32
+	// GetReceiptsRoot returns the receipts root.
+	GetReceiptsRoot() common.Bytes32
+	// GetLogsBloom returns the logs bloom.
+	GetLogsBloom() []byte
+	// GetBlobGasUsed returns the blob gas used.
+	GetBlobGasUsed() math.U64
+	// GetExcessBlobGas returns the excess blob gas.
+	GetExcessBlobGas() math.U64
+	// GetWithdrawals returns the withdrawals.
+	GetWithdrawals() []*engineprimitives.Withdrawal
+	// GetTransactions returns the transactions.
+	GetTransactions() [][]byte
 }
 
 // ExecutionPayloadHeader is the interface for the execution payload header.
@@ -192,6 +265,8 @@ type ReadOnlyBeaconState[
 	)
 	// GetSlot retrieves the current slot of the beacon state.
 	GetSlot() (math.Slot, error)
+	// GetGenesisTime retrieves the genesis time of the chain.
+	GetGenesisTime() (uint64, error)
 	// HashTreeRoot returns the hash tree root of the beacon state.
 	HashTreeRoot() ([32]byte, error)
 }
@@ -247,7 +322,15 @@ type TelemetrySink interface {
 	// the provided key.
 	IncrementCounter(key string, args ...string)
 
+	// AddCounter adds the given value to the counter identified by the
+	// provided key.
+	AddCounter(key string, value uint64, args ...string)
+
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
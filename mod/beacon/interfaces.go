@@ -47,8 +47,9 @@ type DepositStore[DepositT any] interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]DepositT, error)
-	// Prune prunes the deposit store of [start, end)
-	Prune(start, end uint64) error
+	// Prune prunes the deposit store of [start, end) and returns the number
+	// of deposits pruned.
+	Prune(start, end uint64) (uint64, error)
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
 }
@@ -35,6 +35,9 @@ const (
 	DefaultSecretFileName = "jwt.hex"
 	FlagOutputPath        = "output-path"
 	FlagInputPath         = "input-path"
+
+	// jwtSecretFileMode restricts the secret file to its owner.
+	jwtSecretFileMode = 0o600
 )
 
 // Commands creates a new command for managing JWT secrets.
@@ -155,7 +158,7 @@ func generateAuthSecretInFile(cmd *cobra.Command, fileName string) error {
 	}
 
 	if err = afero.WriteFile(
-		fs, fileName, []byte(secret.Hex()), os.ModePerm,
+		fs, fileName, []byte(secret.Hex()), jwtSecretFileMode,
 	); err != nil {
 		return err
 	}
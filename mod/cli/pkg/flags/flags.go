@@ -46,9 +46,11 @@ const (
 	RPCRetries              = engineRoot + "rpc-retries"
 	RPCTimeout              = engineRoot + "rpc-timeout"
 	RPCStartupCheckInterval = engineRoot + "rpc-startup-check-interval"
+	RPCConnectTimeout       = engineRoot + "rpc-connect-timeout"
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	JWTStrictPermissions    = engineRoot + "jwt-secret-strict-permissions"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -64,6 +66,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.JWTSecretPath,
 		"path to the execution client secret",
 	)
+	startCmd.Flags().Bool(
+		JWTStrictPermissions,
+		defaultCfg.Engine.JWTSecretStrictPermissions,
+		"refuse a jwt secret file accessible by group or others",
+	)
 	startCmd.Flags().String(
 		RPCDialURL, defaultCfg.Engine.RPCDialURL.String(), "rpc dial url",
 	)
@@ -78,6 +85,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCStartupCheckInterval,
 		"rpc startup check interval",
 	)
+	startCmd.Flags().Duration(
+		RPCConnectTimeout,
+		defaultCfg.Engine.RPCConnectTimeout,
+		"rpc connect timeout",
+	)
 	startCmd.Flags().Duration(
 		RPCJWTRefreshInterval,
 		defaultCfg.Engine.RPCJWTRefreshInterval,
@@ -100,6 +100,7 @@ func BaseSpec() chain.SpecData[
 		DepositEth1ChainID:        uint64(80084),
 		Eth1FollowDistance:        1,
 		TargetSecondsPerEth1Block: 3,
+		MaxExtraDataBytes:         32,
 		// Fork-related values.
 		ElectraForkEpoch: 9999999999999999,
 		// State list length constants.
@@ -121,6 +122,8 @@ func BaseSpec() chain.SpecData[
 		FieldElementsPerBlob:             4096,
 		BytesPerBlob:                     131072,
 		KZGCommitmentInclusionProofDepth: 17,
-		CometValues:                      cmtConsensusParams,
+		// Electra values.
+		MaxWithdrawalRequestsPerPayload: 16,
+		CometValues:                     cmtConsensusParams,
 	}
 }
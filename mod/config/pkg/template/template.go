@@ -39,12 +39,30 @@ rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 # Interval for the startup check.
 rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 
+# Timeout for dialing the execution client and completing the connection handshake.
+rpc-connect-timeout = "{{ .BeaconKit.Engine.RPCConnectTimeout }}"
+
+# Maximum time to wait for the execution client to become reachable on startup.
+# Zero waits indefinitely.
+rpc-startup-timeout = "{{ .BeaconKit.Engine.RPCStartupTimeout }}"
+
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Number of HTTP connections to the execution client kept open for concurrent requests.
+rpc-connection-pool-size = "{{ .BeaconKit.Engine.RPCConnectionPoolSize }}"
+
+# Number of invalid payload hashes cached to reject repeated new payload calls.
+# Zero disables the cache.
+payload-cache-size = "{{ .BeaconKit.Engine.PayloadCacheSize }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Refuse to start if the JWT secret file is accessible by group or others,
+# rather than only warning about it.
+jwt-secret-strict-permissions = {{ .BeaconKit.Engine.JWTSecretStrictPermissions }}
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -73,4 +91,44 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
+
+[beacon-kit.blockchain]
+# OptimisticModeEnabled imports blocks whose payloads the execution client has not yet
+# validated (SYNCING or ACCEPTED), tracking them until they are reported as VALID.
+# Disabling it refuses such blocks, which is safer but halts the node if the execution
+# client cannot validate a payload during block finalization.
+optimistic-mode-enabled = {{ .BeaconKit.BlockChain.OptimisticModeEnabled }}
+
+# Maximum number of attempts made to deliver a forkchoice update while the execution
+# client cannot be reached. Explicit responses, such as INVALID, are never retried.
+forkchoice-update-attempts = {{ .BeaconKit.BlockChain.ForkchoiceUpdateAttempts }}
+
+# Delay before the first retry of a forkchoice update. It doubles with every retry.
+forkchoice-update-retry-delay = "{{ .BeaconKit.BlockChain.ForkchoiceUpdateRetryDelay }}"
+
+# Backend of the blob sidecar store, either "disk" or "memory". Blobs kept in memory
+# are lost on restart. Both backends are pruned outside of the DA period.
+availability-store-backend = "{{ .BeaconKit.BlockChain.AvailabilityStoreBackend }}"
+
+# Validate payloads and blocks and process blobs and blocks strictly in order instead
+# of in parallel. This is slower, but makes logs deterministic when debugging a
+# failing block.
+sequential-validation = {{ .BeaconKit.BlockChain.SequentialValidation }}
+
+[beacon-kit.deposit]
+# Timeout for a single deposit log query to the execution client. Blocks whose
+# query times out are retried later. Zero uses the default of 10s.
+log-query-timeout = "{{ .BeaconKit.Deposit.LogQueryTimeout }}"
+
+# Maximum number of blocks covered by a single deposit log query. Larger ranges
+# are split into several queries.
+log-query-chunk-size = {{ .BeaconKit.Deposit.LogQueryChunkSize }}
+
+# Stop processing deposits when handling a block panics, instead of logging the
+# panic and carrying on with the next block.
+halt-on-panic = {{ .BeaconKit.Deposit.HaltOnPanic }}
+
+# How long the deposit service may go without reading any deposit logs before
+# it reports itself as unhealthy. Zero disables the check.
+staleness-threshold = "{{ .BeaconKit.Deposit.StalenessThreshold }}"
 `
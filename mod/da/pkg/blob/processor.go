@@ -89,6 +89,9 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) VerifyBlobs(
 }
 
 // ProcessBlobs processes the blobs and ensures they match the local state.
+// The inclusion proof of every sidecar is checked before any of them are
+// persisted, so that sidecars not committed to by the block body are never
+// stored.
 func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) ProcessBlobs(
 	slot math.Slot,
 	avs AvailabilityStoreT,
@@ -99,5 +102,15 @@ func (sp *Processor[AvailabilityStoreT, BeaconBlockBodyT]) ProcessBlobs(
 		startTime, math.U64(sidecars.Len()),
 	)
 
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return nil
+	}
+
+	if err := sp.verifier.VerifyInclusionProofs(
+		sidecars, sp.blockBodyOffsetFn(slot, sp.chainSpec),
+	); err != nil {
+		return err
+	}
+
 	return avs.Persist(slot, sidecars)
 }
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// wordSize is the number of bits in a word of the filter.
+const wordSize = 64
+
+// BloomFilter is a concurrency safe bloom filter. It may report false
+// positives, but never false negatives, for keys that were added to it.
+type BloomFilter struct {
+	mu sync.RWMutex
+	// bits holds the bits of the filter.
+	bits []uint64
+	// numBits is the number of bits in the filter.
+	numBits uint64
+	// numHashes is the number of bits set per key.
+	numHashes uint64
+}
+
+// NewBloomFilter creates a new BloomFilter of numBits bits, setting
+// numHashes bits per key.
+func NewBloomFilter(numBits, numHashes uint64) *BloomFilter {
+	numBits = max((numBits+wordSize-1)/wordSize*wordSize, wordSize)
+	return &BloomFilter{
+		bits:      make([]uint64, numBits/wordSize),
+		numBits:   numBits,
+		numHashes: max(numHashes, 1),
+	}
+}
+
+// Add adds the key to the filter.
+func (f *BloomFilter) Add(key []byte) {
+	h1, h2 := hashKey(key)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := range f.numHashes {
+		bit := (h1 + i*h2) % f.numBits
+		f.bits[bit/wordSize] |= 1 << (bit % wordSize)
+	}
+}
+
+// MayContain reports whether the key may have been added to the filter. A
+// false result means the key was definitely never added.
+func (f *BloomFilter) MayContain(key []byte) bool {
+	h1, h2 := hashKey(key)
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for i := range f.numHashes {
+		bit := (h1 + i*h2) % f.numBits
+		if f.bits[bit/wordSize]&(1<<(bit%wordSize)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hashKey derives the two hashes used for double hashing the key. The second
+// hash is forced to be odd so that it never degenerates to zero.
+func hashKey(key []byte) (uint64, uint64) {
+	h1 := fnv.New64a()
+	h2 := fnv.New64()
+	// Writing to a hash never returns an error.
+	_, _ = h1.Write(key)
+	_, _ = h2.Write(key)
+	return h1.Sum64(), h2.Sum64() | 1
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	var (
+		f   = store.NewBloomFilter(1<<16, 4)
+		key = func(i uint64) []byte {
+			return binary.LittleEndian.AppendUint64(nil, i)
+		}
+	)
+
+	require.False(t, f.MayContain(key(0)))
+	for i := range uint64(1000) {
+		f.Add(key(i))
+	}
+
+	// Keys that were added must always be reported.
+	for i := range uint64(1000) {
+		require.True(t, f.MayContain(key(i)))
+	}
+
+	// Keys that were never added are mostly reported as absent.
+	var falsePositives int
+	for i := uint64(1000); i < 2000; i++ {
+		if f.MayContain(key(i)) {
+			falsePositives++
+		}
+	}
+	require.Less(t, falsePositives, 10)
+}
+
+func TestBloomFilterMinimumSize(t *testing.T) {
+	f := store.NewBloomFilter(0, 0)
+	require.False(t, f.MayContain([]byte("sidecar")))
+	f.Add([]byte("sidecar"))
+	require.True(t, f.MayContain([]byte("sidecar")))
+}
@@ -22,6 +22,8 @@ package store
 
 import (
 	"context"
+	"encoding/binary"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -31,6 +33,13 @@ import (
 	"github.com/sourcegraph/conc/iter"
 )
 
+const (
+	// filterBits is the size in bits of the filter of stored sidecars.
+	filterBits = 1 << 23
+	// filterHashes is the number of bits set per sidecar in the filter.
+	filterHashes = 4
+)
+
 // Store is the default implementation of the AvailabilityStore.
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
@@ -39,6 +48,15 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// filter holds the sidecars persisted by this store, allowing
+	// IsDataAvailable to skip the database for sidecars that are definitely
+	// missing.
+	filter *BloomFilter
+	// filterFrom is one past the first slot persisted by this store. Only
+	// slots from filterFrom onwards are guaranteed to be fully covered by the
+	// filter, since earlier ones may have been stored before a restart. It
+	// is zero until the first sidecars are persisted.
+	filterFrom atomic.Uint64
 }
 
 // New creates a new instance of the AvailabilityStore.
@@ -51,6 +69,7 @@ func New[BeaconBlockT BeaconBlockBody](
 		IndexDB:   db,
 		chainSpec: chainSpec,
 		logger:    logger,
+		filter:    NewBloomFilter(filterBits, filterHashes),
 	}
 }
 
@@ -61,7 +80,15 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	slot math.Slot,
 	body BeaconBlockBodyT,
 ) bool {
+	from := s.filterFrom.Load()
 	for _, commitment := range body.GetBlobKzgCommitments() {
+		// If the filter covers the slot and has never seen the sidecar, it
+		// is definitely not stored.
+		if from != 0 && slot.Unwrap() >= from &&
+			!s.filter.MayContain(filterKey(slot, commitment[:])) {
+			return false
+		}
+
 		// Check if the block data is available in the IndexDB
 		blockData, err := s.IndexDB.Has(uint64(slot), commitment[:])
 		if err != nil || !blockData {
@@ -106,12 +133,24 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(uint64(slot), sc.KzgCommitment[:], bz)
+			if err = s.Set(uint64(slot), sc.KzgCommitment[:], bz); err != nil {
+				return err
+			}
+			s.filter.Add(filterKey(slot, sc.KzgCommitment[:]))
+			return nil
 		},
 	)...); err != nil {
 		return err
 	}
+	s.filterFrom.CompareAndSwap(0, slot.Unwrap()+1)
 
 	s.logger.Info("Successfully stored all blob sidecars 🚗", "slot", slot)
 	return nil
 }
+
+// filterKey returns the key of a sidecar in the filter.
+func filterKey(slot math.Slot, commitment []byte) []byte {
+	return append(
+		binary.LittleEndian.AppendUint64(nil, slot.Unwrap()), commitment...,
+	)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package storetest provides availability store implementations for use in
+// tests.
+package storetest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// MemoryAvailabilityStore is a concurrency-safe, in-memory availability store.
+// Unlike store.Store it keeps every sidecar it is given, regardless of the
+// DA period, and is intended for tests only.
+type MemoryAvailabilityStore[BeaconBlockBodyT store.BeaconBlockBody] struct {
+	// mu protects sidecars.
+	mu sync.RWMutex
+	// sidecars maps a slot to the sidecars stored for it, keyed by their
+	// KZG commitment.
+	sidecars map[math.Slot]map[eip4844.KZGCommitment]*types.BlobSidecar
+}
+
+// NewMemoryAvailabilityStore creates a new, empty MemoryAvailabilityStore.
+func NewMemoryAvailabilityStore[
+	BeaconBlockBodyT store.BeaconBlockBody,
+]() *MemoryAvailabilityStore[BeaconBlockBodyT] {
+	return &MemoryAvailabilityStore[BeaconBlockBodyT]{
+		sidecars: make(
+			map[math.Slot]map[eip4844.KZGCommitment]*types.BlobSidecar,
+		),
+	}
+}
+
+// IsDataAvailable returns true if a sidecar is stored for every blob
+// referenced in the block body.
+func (s *MemoryAvailabilityStore[BeaconBlockBodyT]) IsDataAvailable(
+	_ context.Context,
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stored := s.sidecars[slot]
+	for _, commitment := range body.GetBlobKzgCommitments() {
+		if _, ok := stored[commitment]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Persist stores the given sidecars under the given slot.
+func (s *MemoryAvailabilityStore[BeaconBlockBodyT]) Persist(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) error {
+	if sidecars.IsNil() || sidecars.Len() == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.sidecars[slot]
+	if !ok {
+		stored = make(map[eip4844.KZGCommitment]*types.BlobSidecar)
+		s.sidecars[slot] = stored
+	}
+	for _, sidecar := range sidecars.Sidecars {
+		if sidecar == nil {
+			return store.ErrAttemptedToStoreNilSidecar
+		}
+		stored[sidecar.KzgCommitment] = sidecar
+	}
+	return nil
+}
+
+// Sidecars returns the sidecars stored for the given slot.
+func (s *MemoryAvailabilityStore[BeaconBlockBodyT]) Sidecars(
+	slot math.Slot,
+) []*types.BlobSidecar {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sidecars := make([]*types.BlobSidecar, 0, len(s.sidecars[slot]))
+	for _, sidecar := range s.sidecars[slot] {
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storetest_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store/storetest"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type commitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+// mockBody is a block body referencing a fixed set of commitments.
+type mockBody struct {
+	commitments commitments
+}
+
+func (b mockBody) GetBlobKzgCommitments() commitments {
+	return b.commitments
+}
+
+func TestMemoryAvailabilityStore(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		s    = storetest.NewMemoryAvailabilityStore[mockBody]()
+		c1   = eip4844.KZGCommitment{1}
+		c2   = eip4844.KZGCommitment{2}
+		body = mockBody{
+			commitments: commitments{c1, c2},
+		}
+	)
+
+	require.True(t, s.IsDataAvailable(ctx, 1, mockBody{}))
+	require.False(t, s.IsDataAvailable(ctx, 1, body))
+
+	require.NoError(t, s.Persist(1, &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{{KzgCommitment: c1}},
+	}))
+	require.False(t, s.IsDataAvailable(ctx, 1, body))
+
+	require.NoError(t, s.Persist(1, &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{{Index: 1, KzgCommitment: c2}},
+	}))
+	require.True(t, s.IsDataAvailable(ctx, 1, body))
+	require.Len(t, s.Sidecars(1), 2)
+
+	// Sidecars are keyed by slot.
+	require.False(t, s.IsDataAvailable(ctx, 2, body))
+}
+
+func TestMemoryAvailabilityStore_NilSidecar(t *testing.T) {
+	s := storetest.NewMemoryAvailabilityStore[mockBody]()
+	err := s.Persist(1, &types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{nil},
+	})
+	require.ErrorIs(t, err, store.ErrAttemptedToStoreNilSidecar)
+}
+
+func TestMemoryAvailabilityStore_Concurrent(t *testing.T) {
+	var (
+		s  = storetest.NewMemoryAvailabilityStore[mockBody]()
+		wg sync.WaitGroup
+	)
+	for i := range 16 {
+		wg.Add(1)
+		go func(slot math.Slot) {
+			defer wg.Done()
+			//#nosec:G701 // slot is below 16.
+			c := eip4844.KZGCommitment{byte(slot)}
+			require.NoError(t, s.Persist(slot, &types.BlobSidecars{
+				Sidecars: []*types.BlobSidecar{{KzgCommitment: c}},
+			}))
+			require.True(t, s.IsDataAvailable(
+				context.Background(), slot, mockBody{commitments: commitments{c}},
+			))
+		}(math.Slot(i))
+	}
+	wg.Wait()
+}
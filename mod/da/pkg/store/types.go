@@ -36,10 +36,14 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 	Data() BeaconBlockT
 }
 
-// IndexDB is a database that allows prefixing by index.
+// IndexDB is a database that allows prefixing by index. It is implemented by
+// both the disk and the memory backends of the store.
 type IndexDB interface {
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	// Prune removes all values in the given range [start, end) and returns
+	// the number of indexes pruned.
+	Prune(start, end uint64) (uint64, error)
 }
 
 // BeaconBlockBody is the body of a beacon block.
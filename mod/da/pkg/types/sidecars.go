@@ -77,7 +77,9 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 
 			// Verify the KZG inclusion proof.
 			if !sc.HasValidInclusionProof(kzgOffset) {
-				return ErrInvalidInclusionProof
+				return errors.Wrapf(
+					ErrInvalidInclusionProof, "sidecar index %d", sc.Index,
+				)
 			}
 			return nil
 		},
@@ -147,3 +147,22 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestVerifyInclusionProofsReportsIndex(t *testing.T) {
+	// A sidecar whose inclusion proof does not match its body root.
+	sidecars := types.BlobSidecars{
+		Sidecars: []*types.BlobSidecar{{
+			Index:             3,
+			Blob:              eip4844.Blob{},
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{BodyRoot: [32]byte{1}},
+			InclusionProof: [][32]byte{
+				byteslib.ToBytes32([]byte("1")),
+				byteslib.ToBytes32([]byte("2")),
+			},
+		}},
+	}
+
+	err := sidecars.VerifyInclusionProofs(0)
+	require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+	require.ErrorContains(t, err, "sidecar index 3")
+}
@@ -55,4 +55,24 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrWithdrawalIndexOutOfRange indicates that a withdrawal index is not
+	// within the withdrawals.
+	ErrWithdrawalIndexOutOfRange = errors.New(
+		"withdrawal index out of range",
+	)
+
+	// ErrInvalidWithdrawalProof indicates that a withdrawal inclusion proof
+	// does not verify against the withdrawals root.
+	ErrInvalidWithdrawalProof = errors.New("invalid withdrawal proof")
+
+	// ErrNilWithdrawal indicates that a withdrawals list contains a nil
+	// withdrawal.
+	ErrNilWithdrawal = errors.New("nil withdrawal")
+
+	// ErrWithdrawalIndicesNotIncreasing indicates that the indices of a
+	// withdrawals list are not strictly increasing.
+	ErrWithdrawalIndicesNotIncreasing = errors.New(
+		"withdrawal indices not strictly increasing",
+	)
 )
@@ -21,10 +21,10 @@
 package engineprimitives
 
 import (
+	"fmt"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
 // Withdrawal represents a validator withdrawal from the consensus layer.
@@ -50,6 +50,15 @@ func (w *Withdrawal) Equals(other *Withdrawal) bool {
 		w.Amount == other.Amount
 }
 
+// String returns a human-readable representation of the Withdrawal, with a
+// truncated address, intended for logging.
+func (w *Withdrawal) String() string {
+	return fmt.Sprintf(
+		"Withdrawal{index=%d validator=%d address=%#x... amount=%dGwei}",
+		w.Index, w.Validator, w.Address[:3], w.Amount,
+	)
+}
+
 // GetIndex returns the unique identifier for the withdrawal.
 func (w *Withdrawal) GetIndex() math.U64 {
 	return w.Index
@@ -70,14 +79,3 @@ func (w *Withdrawal) GetAddress() common.ExecutionAddress {
 func (w *Withdrawal) GetAmount() math.Gwei {
 	return w.Amount
 }
-
-// Withdrawals represents a slice of withdrawals.
-type Withdrawals []*Withdrawal
-
-// HashTreeRoot returns the hash tree root of the Withdrawals list.
-func (w Withdrawals) HashTreeRoot() (common.Root, error) {
-	// TODO: read max withdrawals from the chain spec.
-	return ssz.MerkleizeListComposite[any, math.U64](
-		w, constants.MaxWithdrawalsPerPayload,
-	)
-}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package engineprimitives
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+)
+
+// maxCachedWithdrawalRoots is the maximum number of withdrawal roots held
+// by the cache before it is reset.
+const maxCachedWithdrawalRoots = 64 * constants.MaxWithdrawalsPerPayload
+
+// withdrawalRoots is the process-wide cache used by HashTreeRootCached.
+//
+//nolint:gochecknoglobals // shared cache.
+var withdrawalRoots = &withdrawalRootCache{
+	roots: make(map[Withdrawal]common.Root),
+}
+
+// withdrawalRootCache memoizes withdrawal hash tree roots.
+//
+// NOTE: The cache is keyed by the value of the Withdrawal rather than stored
+// on the Withdrawal itself, since the Withdrawal must keep the same memory
+// layout as the go-ethereum Withdrawal. Keying by value also means a cached
+// root is never returned for a Withdrawal whose fields have changed.
+type withdrawalRootCache struct {
+	mu    sync.RWMutex
+	roots map[Withdrawal]common.Root
+}
+
+// get returns the cached root for the given withdrawal, if present.
+func (c *withdrawalRootCache) get(w Withdrawal) (common.Root, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	root, ok := c.roots[w]
+	return root, ok
+}
+
+// set caches the root for the given withdrawal, resetting the cache if it
+// has grown too large.
+func (c *withdrawalRootCache) set(w Withdrawal, root common.Root) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if uint64(len(c.roots)) >= maxCachedWithdrawalRoots {
+		c.roots = make(map[Withdrawal]common.Root)
+	}
+	c.roots[w] = root
+}
+
+// HashTreeRootCached returns the hash tree root of the Withdrawal, reusing a
+// previously computed root for a Withdrawal with identical fields. The result
+// is always equal to HashTreeRoot and is safe to call concurrently.
+func (w *Withdrawal) HashTreeRootCached() (common.Root, error) {
+	if root, ok := withdrawalRoots.get(*w); ok {
+		return root, nil
+	}
+
+	root, err := w.HashTreeRoot()
+	if err != nil {
+		return common.Root{}, err
+	}
+	withdrawalRoots.set(*w, root)
+	return root, nil
+}
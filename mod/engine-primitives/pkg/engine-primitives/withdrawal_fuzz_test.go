@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzWithdrawalSSZRoundTrip(f *testing.F) {
+	f.Add(make([]byte, 44))
+	f.Add([]byte{
+		1, 0, 0, 0, 0, 0, 0, 0,
+		2, 0, 0, 0, 0, 0, 0, 0,
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
+		11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+		100, 0, 0, 0, 0, 0, 0, 0,
+	})
+	f.Add([]byte{1, 2, 3})
+	f.Add(make([]byte, 45))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		withdrawal := &engineprimitives.Withdrawal{}
+		err := withdrawal.UnmarshalSSZ(data)
+		if len(data) != withdrawal.SizeSSZ() {
+			require.ErrorIs(t, err, ssz.ErrSize)
+			return
+		}
+		require.NoError(t, err)
+
+		// Every byte of the input, including the address, must be decoded,
+		// so re-encoding yields the input unchanged.
+		encoded, err := withdrawal.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, data, encoded)
+
+		decoded := &engineprimitives.Withdrawal{}
+		require.NoError(t, decoded.UnmarshalSSZ(encoded))
+		require.True(t, withdrawal.Equals(decoded))
+	})
+}
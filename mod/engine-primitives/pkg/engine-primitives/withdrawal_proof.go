@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+// WithdrawalInclusionProof returns the Merkle proof of the withdrawal at the
+// given index against the hash tree root of the withdrawals, i.e. the
+// withdrawals root of the execution payload header. The last element of the
+// proof is the mixed in length of the list.
+func WithdrawalInclusionProof(
+	withdrawals Withdrawals,
+	index int,
+) ([][32]byte, error) {
+	if index < 0 || index >= len(withdrawals) {
+		return nil, errors.Wrapf(
+			ErrWithdrawalIndexOutOfRange,
+			"index: %d, withdrawals: %d", index, len(withdrawals),
+		)
+	}
+
+	leaves := make([][32]byte, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		root, err := withdrawal.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = root
+	}
+
+	// TODO: read max withdrawals from the chain spec.
+	tree, err := merkle.NewTreeWithMaxLeaves[[32]byte, [32]byte](
+		leaves, constants.MaxWithdrawalsPerPayload,
+	)
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // index is checked to be non-negative above.
+	return tree.MerkleProofWithMixin(uint64(index))
+}
+
+// VerifyWithdrawalInclusionProof verifies a proof produced by
+// WithdrawalInclusionProof, i.e. that the withdrawal is at the given index of
+// the withdrawals whose hash tree root is withdrawalsRoot.
+func VerifyWithdrawalInclusionProof(
+	withdrawalsRoot common.Root,
+	withdrawal *Withdrawal,
+	index int,
+	proof [][32]byte,
+) error {
+	if index < 0 {
+		return errors.Wrapf(
+			ErrWithdrawalIndexOutOfRange, "index: %d", index,
+		)
+	}
+
+	leaf, err := withdrawal.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	//#nosec:G701 // index is checked to be non-negative above.
+	if !merkle.VerifyProof(
+		withdrawalsRoot, common.Root(leaf), uint64(index), proof,
+	) {
+		return ErrInvalidWithdrawalProof
+	}
+	return nil
+}
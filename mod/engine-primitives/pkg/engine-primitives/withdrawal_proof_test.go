@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalInclusionProof(t *testing.T) {
+	withdrawals := make(engineprimitives.Withdrawals, 5)
+	for i := range withdrawals {
+		withdrawals[i] = &engineprimitives.Withdrawal{
+			Index:     math.U64(i),
+			Validator: math.ValidatorIndex(i),
+			Address:   common.ExecutionAddress{byte(i)},
+			Amount:    math.Gwei(1000 * (i + 1)),
+		}
+	}
+	root, err := withdrawals.HashTreeRoot()
+	require.NoError(t, err)
+
+	for i, withdrawal := range withdrawals {
+		proof, proofErr := engineprimitives.WithdrawalInclusionProof(
+			withdrawals, i,
+		)
+		require.NoError(t, proofErr)
+		require.NoError(t, engineprimitives.VerifyWithdrawalInclusionProof(
+			root, withdrawal, i, proof,
+		))
+	}
+
+	proof, err := engineprimitives.WithdrawalInclusionProof(withdrawals, 1)
+	require.NoError(t, err)
+	require.ErrorIs(t, engineprimitives.VerifyWithdrawalInclusionProof(
+		root, withdrawals[1], 2, proof,
+	), engineprimitives.ErrInvalidWithdrawalProof)
+	require.ErrorIs(t, engineprimitives.VerifyWithdrawalInclusionProof(
+		root, withdrawals[2], 1, proof,
+	), engineprimitives.ErrInvalidWithdrawalProof)
+}
+
+func TestWithdrawalInclusionProof_OutOfRange(t *testing.T) {
+	withdrawals := engineprimitives.Withdrawals{
+		&engineprimitives.Withdrawal{Index: math.U64(1)},
+	}
+
+	_, err := engineprimitives.WithdrawalInclusionProof(withdrawals, 1)
+	require.ErrorIs(t, err, engineprimitives.ErrWithdrawalIndexOutOfRange)
+	_, err = engineprimitives.WithdrawalInclusionProof(withdrawals, -1)
+	require.ErrorIs(t, err, engineprimitives.ErrWithdrawalIndexOutOfRange)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// WithdrawalRequest is a withdrawal triggered from the execution layer, as
+// per EIP-7002:
+// https://eips.ethereum.org/EIPS/eip-7002
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path withdrawal_request.go -objs WithdrawalRequest -include ../../../primitives/pkg/math,../../../primitives/pkg/common,../../../primitives/pkg/crypto,../../../primitives/pkg/bytes,$GETH_PKG_INCLUDE/common,$GETH_PKG_INCLUDE/common/hexutil -output withdrawal_request.ssz.go
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that triggered the withdrawal.
+	// It must match the withdrawal credentials of the validator.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"   ssz-size:"20"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey" ssz-size:"48"`
+	// Amount is the amount of Gwei to be withdrawn. A zero amount requests
+	// a full exit of the validator.
+	Amount math.Gwei `json:"amount"`
+}
+
+// GetSourceAddress returns the execution address that triggered the
+// withdrawal.
+func (w *WithdrawalRequest) GetSourceAddress() common.ExecutionAddress {
+	return w.SourceAddress
+}
+
+// GetValidatorPubkey returns the public key of the validator to withdraw
+// from.
+func (w *WithdrawalRequest) GetValidatorPubkey() crypto.BLSPubkey {
+	return w.ValidatorPubkey
+}
+
+// GetAmount returns the amount of Gwei to be withdrawn.
+func (w *WithdrawalRequest) GetAmount() math.Gwei {
+	return w.Amount
+}
+
+// IsFullExit reports whether the request asks for a full exit of the
+// validator rather than a partial withdrawal.
+func (w *WithdrawalRequest) IsFullExit() bool {
+	return w.Amount == 0
+}
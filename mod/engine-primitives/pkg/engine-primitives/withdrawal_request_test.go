@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalRequestSSZ(t *testing.T) {
+	request := &engineprimitives.WithdrawalRequest{
+		SourceAddress:   [20]byte{1, 2, 3},
+		ValidatorPubkey: [48]byte{4, 5, 6},
+		Amount:          math.Gwei(100),
+	}
+
+	data, err := request.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, request.SizeSSZ())
+	require.Equal(t, 76, request.SizeSSZ())
+
+	decoded := new(engineprimitives.WithdrawalRequest)
+	require.NoError(t, decoded.UnmarshalSSZ(data))
+	require.Equal(t, request, decoded)
+
+	root, err := request.HashTreeRoot()
+	require.NoError(t, err)
+	decodedRoot, err := decoded.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, decodedRoot)
+
+	tree, err := request.GetTree()
+	require.NoError(t, err)
+	require.NotNil(t, tree)
+}
+
+func TestWithdrawalRequestUnmarshalSSZInvalidSize(t *testing.T) {
+	request := new(engineprimitives.WithdrawalRequest)
+	require.ErrorIs(t, request.UnmarshalSSZ(make([]byte, 75)), ssz.ErrSize)
+}
+
+func TestWithdrawalRequestIsFullExit(t *testing.T) {
+	require.True(t, (&engineprimitives.WithdrawalRequest{}).IsFullExit())
+	require.False(t, (&engineprimitives.WithdrawalRequest{
+		Amount: math.Gwei(1),
+	}).IsFullExit())
+}
@@ -24,7 +24,9 @@ import (
 	"testing"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,3 +65,84 @@ func TestWithdrawalGetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestWithdrawalsSSZ(t *testing.T) {
+	withdrawals := engineprimitives.Withdrawals{
+		{
+			Index:     math.U64(1),
+			Validator: math.ValidatorIndex(2),
+			Address:   [20]byte{1, 2, 3},
+			Amount:    math.Gwei(100),
+		},
+		{
+			Index:     math.U64(2),
+			Validator: math.ValidatorIndex(3),
+			Address:   [20]byte{4, 5, 6},
+			Amount:    math.Gwei(200),
+		},
+	}
+
+	data, err := withdrawals.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, withdrawals.SizeSSZ())
+	require.Equal(t, 88, withdrawals.SizeSSZ())
+
+	var unmarshalled engineprimitives.Withdrawals
+	err = unmarshalled.UnmarshalSSZ(data)
+	require.NoError(t, err)
+	require.Len(t, unmarshalled, len(withdrawals))
+	for i := range withdrawals {
+		require.True(t, withdrawals[i].Equals(unmarshalled[i]))
+	}
+
+	root, err := withdrawals.HashTreeRoot()
+	require.NoError(t, err)
+	unmarshalledRoot, err := unmarshalled.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, unmarshalledRoot)
+}
+
+func TestWithdrawalsSSZ_Errors(t *testing.T) {
+	var withdrawals engineprimitives.Withdrawals
+
+	// The buffer must be a multiple of the withdrawal size.
+	err := withdrawals.UnmarshalSSZ(make([]byte, 45))
+	require.ErrorIs(t, err, ssz.ErrSize)
+
+	// The list must not exceed the maximum number of withdrawals.
+	err = withdrawals.UnmarshalSSZ(
+		make([]byte, 44*(constants.MaxWithdrawalsPerPayload+1)),
+	)
+	require.ErrorIs(t, err, ssz.ErrListTooBig)
+
+	tooMany := make(
+		engineprimitives.Withdrawals, constants.MaxWithdrawalsPerPayload+1,
+	)
+	for i := range tooMany {
+		tooMany[i] = &engineprimitives.Withdrawal{}
+	}
+	_, err = tooMany.MarshalSSZ()
+	require.Error(t, err)
+}
+
+func TestWithdrawalsMarshalSSZStrict(t *testing.T) {
+	withdrawals := engineprimitives.Withdrawals{
+		{Index: math.U64(1), Validator: math.ValidatorIndex(2)},
+		{Index: math.U64(2), Validator: math.ValidatorIndex(3)},
+	}
+	strict, err := withdrawals.MarshalSSZStrict()
+	require.NoError(t, err)
+	data, err := withdrawals.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, data, strict)
+
+	// The indices must be strictly increasing.
+	withdrawals[1].Index = withdrawals[0].Index
+	_, err = withdrawals.MarshalSSZStrict()
+	require.ErrorIs(t, err, engineprimitives.ErrWithdrawalIndicesNotIncreasing)
+
+	// The list must not contain nil withdrawals.
+	withdrawals[1] = nil
+	_, err = withdrawals.MarshalSSZStrict()
+	require.ErrorIs(t, err, engineprimitives.ErrNilWithdrawal)
+}
@@ -90,3 +90,45 @@ func TestWithdrawal_Equals(t *testing.T) {
 	// Test that Equals returns false for two different withdrawals
 	require.False(t, withdrawal1.Equals(withdrawal3))
 }
+
+func TestWithdrawal_HashTreeRootCached(t *testing.T) {
+	withdrawal := &engineprimitives.Withdrawal{
+		Index:     math.U64(1),
+		Validator: math.ValidatorIndex(1),
+		Address:   common.ExecutionAddress{1, 2, 3, 4, 5},
+		Amount:    math.Gwei(1000),
+	}
+
+	expected, err := withdrawal.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The cached root must match the uncached root, on both a miss and a hit.
+	for range 2 {
+		root, errCached := withdrawal.HashTreeRootCached()
+		require.NoError(t, errCached)
+		require.Equal(t, common.Root(expected), root)
+	}
+
+	// Mutating the withdrawal must not return a stale root.
+	withdrawal.Amount = math.Gwei(2000)
+	expected, err = withdrawal.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := withdrawal.HashTreeRootCached()
+	require.NoError(t, err)
+	require.Equal(t, common.Root(expected), root)
+}
+
+func TestWithdrawal_String(t *testing.T) {
+	withdrawal := &engineprimitives.Withdrawal{
+		Index:     math.U64(5),
+		Validator: math.ValidatorIndex(12),
+		Address:   common.ExecutionAddress{0xab, 0xcd, 0xef, 0x01},
+		Amount:    math.Gwei(32000000000),
+	}
+
+	require.Equal(t,
+		"Withdrawal{index=5 validator=12 address=0xabcdef... "+
+			"amount=32000000000Gwei}",
+		withdrawal.String(),
+	)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// withdrawalSize is the size of a SSZ encoded Withdrawal in bytes.
+const withdrawalSize = 44
+
+// Withdrawals represents a slice of withdrawals.
+type Withdrawals []*Withdrawal
+
+// MarshalSSZ marshals the Withdrawals list into SSZ format.
+func (w Withdrawals) MarshalSSZ() ([]byte, error) {
+	return w.MarshalSSZTo(make([]byte, 0, w.SizeSSZ()))
+}
+
+// MarshalSSZTo marshals the Withdrawals list into SSZ format and appends it
+// to the given buffer.
+func (w Withdrawals) MarshalSSZTo(buf []byte) ([]byte, error) {
+	if size := len(w); uint64(size) > constants.MaxWithdrawalsPerPayload {
+		return buf, fastssz.ErrListTooBigFn(
+			"Withdrawals", size, int(constants.MaxWithdrawalsPerPayload),
+		)
+	}
+
+	var err error
+	for _, withdrawal := range w {
+		if buf, err = withdrawal.MarshalSSZTo(buf); err != nil {
+			return buf, err
+		}
+	}
+	return buf, nil
+}
+
+// MarshalSSZStrict marshals the Withdrawals list into SSZ format like
+// MarshalSSZ, but first validates that the list is consistent: it must not
+// contain nil withdrawals, and the withdrawal indices must be strictly
+// increasing.
+func (w Withdrawals) MarshalSSZStrict() ([]byte, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return w.MarshalSSZ()
+}
+
+// Validate checks that the list contains no nil withdrawals and that the
+// withdrawal indices are strictly increasing.
+func (w Withdrawals) Validate() error {
+	for i, withdrawal := range w {
+		if withdrawal == nil {
+			return errors.Wrapf(ErrNilWithdrawal, "at index %d", i)
+		}
+		if i > 0 && withdrawal.Index <= w[i-1].Index {
+			return errors.Wrapf(
+				ErrWithdrawalIndicesNotIncreasing,
+				"withdrawal index %d at position %d follows %d",
+				withdrawal.Index, i, w[i-1].Index,
+			)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZ unmarshals the Withdrawals list from SSZ format. It returns
+// fastssz.ErrListTooBig if the list exceeds MaxWithdrawalsPerPayload.
+func (w *Withdrawals) UnmarshalSSZ(buf []byte) error {
+	if len(buf)%withdrawalSize != 0 {
+		return fastssz.ErrSize
+	}
+
+	num := len(buf) / withdrawalSize
+	if uint64(num) > constants.MaxWithdrawalsPerPayload {
+		return fastssz.ErrListTooBig
+	}
+
+	withdrawals := make(Withdrawals, num)
+	for i := range num {
+		withdrawals[i] = new(Withdrawal)
+		if err := withdrawals[i].UnmarshalSSZ(
+			buf[i*withdrawalSize : (i+1)*withdrawalSize],
+		); err != nil {
+			return err
+		}
+	}
+	*w = withdrawals
+	return nil
+}
+
+// SizeSSZ returns the size of the SSZ encoded Withdrawals list in bytes.
+func (w Withdrawals) SizeSSZ() int {
+	return len(w) * withdrawalSize
+}
+
+// HashTreeRoot returns the hash tree root of the Withdrawals list.
+func (w Withdrawals) HashTreeRoot() (common.Root, error) {
+	// TODO: read max withdrawals from the chain spec.
+	return ssz.MerkleizeListComposite[any, math.U64](
+		w, constants.MaxWithdrawalsPerPayload,
+	)
+}
@@ -128,4 +128,9 @@ var (
 	ErrEngineAPITimeout = errors.New(
 		"engine API call timed out",
 	)
+
+	// ErrUnsupportedEngineMethod indicates that the execution client did not
+	// report support for an engine API method during capability exchange.
+	ErrUnsupportedEngineMethod = errors.New(
+		"engine does not support method")
 )
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -60,9 +61,14 @@ type EngineClient[
 	metrics *clientMetrics
 	// capabilities is a map of capabilities that the execution client has.
 	capabilities map[string]struct{}
+	// capabilitiesMu guards capabilities.
+	capabilitiesMu sync.RWMutex
 	// engineCache is an all-in-one cache for data
 	// that are retrieved by the EngineClient.
 	engineCache *cache.EngineCache
+	// httpClient is shared by all RPC clients dialed over HTTP(S), so that
+	// its pooled connections survive redials on JWT refresh.
+	httpClient *http.Client
 }
 
 // New creates a new engine client EngineClient.
@@ -89,6 +95,7 @@ func New[ExecutionPayloadT interface {
 		engineCache:  cache.NewEngineCacheWithDefaultConfig(),
 		eth1ChainID:  eth1ChainID,
 		metrics:      newClientMetrics(telemetrySink, logger),
+		httpClient:   newHTTPClient(cfg.RPCConnectionPoolSize),
 	}
 }
 
@@ -121,24 +128,44 @@ func (s *EngineClient[ExecutionPayloadT]) Start(
 	)
 
 	// If the connection connection succeeds, we can skip the
-	// connection initialization loop.
-	if err := s.initializeConnection(ctx); err == nil {
+	// connection initialization loop. A chain ID mismatch will not resolve
+	// itself by retrying, so we surface it immediately.
+	err := s.initializeConnection(ctx)
+	if err == nil {
 		return nil
+	} else if errors.Is(err, ErrMismatchedEth1ChainID) {
+		return err
 	}
 
-	// Attempt to initialize the connection to the execution client.
+	// Attempt to initialize the connection to the execution client, giving
+	// up once the startup timeout elapses so that the caller can decide how
+	// to proceed rather than hang on boot.
+	var startupTimeout <-chan time.Time
+	if s.cfg.RPCStartupTimeout > 0 {
+		timer := time.NewTimer(s.cfg.RPCStartupTimeout)
+		defer timer.Stop()
+		startupTimeout = timer.C
+	}
 	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-startupTimeout:
+			return errors.Wrapf(
+				ErrStartupTimeout, "after %s, last error: %v",
+				s.cfg.RPCStartupTimeout, err,
+			)
 		case <-ticker.C:
 			s.logger.Info(
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.initializeConnection(ctx); err != nil {
+			err = s.initializeConnection(ctx)
+			if errors.Is(err, ErrMismatchedEth1ChainID) {
+				return err
+			} else if err != nil {
 				continue
 			}
 			return nil
@@ -146,14 +173,66 @@ func (s *EngineClient[ExecutionPayloadT]) Start(
 	}
 }
 
+// Healthy returns an error if the execution client cannot be reached or
+// reports a chain ID other than the one configured. It is intended for
+// readiness probes and is bounded by the configured RPC timeout.
+func (s *EngineClient[ExecutionPayloadT]) Healthy(ctx context.Context) error {
+	if s.Client == nil {
+		return ErrNotStarted
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	defer cancel()
+
+	chainID, err := s.Client.ChainID(cctx)
+	if err != nil {
+		return errors.Wrap(err, "execution client unreachable")
+	}
+
+	if chainID.Uint64() != s.eth1ChainID.Uint64() {
+		return errors.Wrapf(
+			ErrMismatchedEth1ChainID,
+			"wanted chain ID %d, got %d",
+			s.eth1ChainID,
+			chainID.Uint64(),
+		)
+	}
+	return nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
-// setupConnection dials the execution client and
-// ensures the chain ID is correct.
+// initializeConnection dials the execution client and ensures the chain ID is
+// correct, bounding the whole handshake by the configured connect timeout so
+// that an execution client that is still starting up cannot stall us
+// indefinitely.
 func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	ctx context.Context,
+) error {
+	if s.cfg.RPCConnectTimeout <= 0 {
+		return s.connect(ctx)
+	}
+
+	cctx, cancel := context.WithTimeoutCause(
+		ctx, s.cfg.RPCConnectTimeout, ErrConnectTimeout,
+	)
+	defer cancel()
+
+	err := s.connect(cctx)
+	if err != nil && errors.Is(context.Cause(cctx), ErrConnectTimeout) {
+		return errors.Wrapf(
+			ErrConnectTimeout, "after %s", s.cfg.RPCConnectTimeout,
+		)
+	}
+	return err
+}
+
+// connect dials the execution client, ensures the chain ID is correct and
+// exchanges capabilities with it.
+func (s *EngineClient[ExecutionPayloadT]) connect(
+	ctx context.Context,
 ) error {
 	var (
 		err     error
@@ -161,7 +240,7 @@ func (s *EngineClient[ExecutionPayloadT]) initializeConnection(
 	)
 
 	defer func() {
-		if err != nil {
+		if err != nil && s.Client != nil {
 			s.Client.Close()
 		}
 	}()
@@ -233,13 +312,17 @@ func (s *EngineClient[ExecutionPayloadT]) dialExecutionRPCClient(
 				return err
 			}
 			if client, err = ethrpc.DialOptions(
-				ctx, s.cfg.RPCDialURL.String(), ethrpc.WithHeaders(header),
+				ctx, s.cfg.RPCDialURL.String(),
+				ethrpc.WithHTTPClient(s.httpClient),
+				ethrpc.WithHeaders(header),
 			); err != nil {
 				return err
 			}
 		} else {
-			if client, err = ethrpc.DialContext(
-				ctx, s.cfg.RPCDialURL.String()); err != nil {
+			if client, err = ethrpc.DialOptions(
+				ctx, s.cfg.RPCDialURL.String(),
+				ethrpc.WithHTTPClient(s.httpClient),
+			); err != nil {
 				return err
 			}
 		}
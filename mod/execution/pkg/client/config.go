@@ -31,7 +31,10 @@ const (
 	defaultRPCRetries              = 3
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
+	defaultRPCConnectTimeout       = 10 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultRPCConnectionPoolSize   = 16
+	defaultPayloadCacheSize        = 64
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -45,7 +48,10 @@ func DefaultConfig() Config {
 		RPCRetries:              defaultRPCRetries,
 		RPCTimeout:              defaultRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
+		RPCConnectTimeout:       defaultRPCConnectTimeout,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCConnectionPoolSize:   defaultRPCConnectionPoolSize,
+		PayloadCacheSize:        defaultPayloadCacheSize,
 		JWTSecretPath:           defaultJWTSecretPath,
 	}
 }
@@ -63,8 +69,23 @@ type Config struct {
 	RPCTimeout time.Duration `mapstructure:"rpc-timeout"`
 	// RPCStartupCheckInterval is the Interval for the startup check.
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
+	// RPCConnectTimeout bounds each attempt to dial the execution client and
+	// perform the chain ID and capabilities handshake.
+	RPCConnectTimeout time.Duration `mapstructure:"rpc-connect-timeout"`
+	// RPCStartupTimeout bounds the time spent waiting for the execution
+	// client to become reachable on startup. Zero waits indefinitely.
+	RPCStartupTimeout time.Duration `mapstructure:"rpc-startup-timeout"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCConnectionPoolSize is the number of HTTP connections to the
+	// execution client kept open for reuse by concurrent requests.
+	RPCConnectionPoolSize uint64 `mapstructure:"rpc-connection-pool-size"`
+	// PayloadCacheSize is the number of invalid payload hashes kept to
+	// reject repeated new payload calls. Zero disables the cache.
+	PayloadCacheSize uint64 `mapstructure:"payload-cache-size"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// JWTSecretStrictPermissions refuses to load a JWT secret file that is
+	// accessible by group or others, rather than only warning about it.
+	JWTSecretStrictPermissions bool `mapstructure:"jwt-secret-strict-permissions"`
 }
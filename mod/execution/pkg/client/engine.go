@@ -104,6 +104,16 @@ func (s *EngineClient[ExecutionPayloadT]) ForkchoiceUpdated(
 		)
 	}
 
+	// Fail with a clear error if the execution client is too old to
+	// support the forkchoice update method of the fork.
+	if forkVersion == version.Deneb &&
+		!s.hasCapability(ethclient.ForkchoiceUpdatedMethodV3) {
+		return nil, nil, errors.Wrap(
+			engineerrors.ErrUnsupportedEngineMethod,
+			ethclient.ForkchoiceUpdatedMethodV3,
+		)
+	}
+
 	result, err := s.Eth1Client.ForkchoiceUpdated(
 		cctx, state, attrs, forkVersion,
 	)
@@ -171,6 +181,9 @@ func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 		return nil, err
 	}
 
+	s.capabilitiesMu.Lock()
+	defer s.capabilitiesMu.Unlock()
+
 	// Capture and log the capabilities that the execution client has.
 	for _, capability := range result {
 		s.logger.Info("Exchanged capability", "capability", capability)
@@ -189,3 +202,12 @@ func (s *EngineClient[ExecutionPayloadT]) ExchangeCapabilities(
 
 	return result, nil
 }
+
+// hasCapability reports whether the execution client reported support for
+// the given engine API method when capabilities were exchanged.
+func (s *EngineClient[ExecutionPayloadT]) hasCapability(method string) bool {
+	s.capabilitiesMu.RLock()
+	defer s.capabilitiesMu.RUnlock()
+	_, ok := s.capabilities[method]
+	return ok
+}
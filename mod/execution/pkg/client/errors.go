@@ -51,6 +51,19 @@ var (
 	// ErrFailedToRefreshJWT indicates that the JWT could not be refreshed.
 	ErrFailedToRefreshJWT = errors.New("failed to refresh auth token")
 
+	// ErrConnectTimeout indicates that dialing the execution client and
+	// completing the connection handshake took longer than the configured
+	// timeout.
+	ErrConnectTimeout = errors.New(
+		"timed out connecting to execution client",
+	)
+
+	// ErrStartupTimeout indicates that the execution client did not become
+	// reachable within the configured startup timeout.
+	ErrStartupTimeout = errors.New(
+		"timed out waiting for execution client to start",
+	)
+
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import "net/http"
+
+// newHTTPClient creates the HTTP client used to reach the execution client,
+// keeping up to poolSize connections open for reuse. Without this, the
+// default transport keeps only two idle connections per host, so concurrent
+// engine calls keep opening and closing connections.
+//
+// The JWT is not handled by the transport: it is attached as a header by the
+// RPC client, which is redialed with a fresh header on every JWT refresh.
+// The pooled connections are reused across those redials.
+func newHTTPClient(poolSize uint64) *http.Client {
+	// Config files written before the pool size existed leave it unset.
+	if poolSize == 0 {
+		poolSize = defaultRPCConnectionPoolSize
+	}
+
+	//nolint:errcheck // the default transport is always an *http.Transport.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	//#nosec:G701 // the pool size will never exceed int max.
+	transport.MaxIdleConns = int(poolSize)
+	//#nosec:G701 // the pool size will never exceed int max.
+	transport.MaxIdleConnsPerHost = int(poolSize)
+	return &http.Client{Transport: transport}
+}
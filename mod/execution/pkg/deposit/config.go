@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "time"
+
+const (
+	// defaultLogQueryTimeout is the default timeout for a single deposit log
+	// query against the execution client.
+	defaultLogQueryTimeout = 10 * time.Second
+	// defaultLogQueryChunkSize is the default maximum number of blocks
+	// covered by a single deposit log query.
+	defaultLogQueryChunkSize = 1000
+)
+
+// Config is the configuration for the deposit service.
+//
+//nolint:lll // struct tags.
+type Config struct {
+	// LogQueryTimeout bounds each deposit log query sent to the execution
+	// client. Blocks whose query times out are retried by the catch-up
+	// fetcher. Zero uses the default timeout, since a query that times out
+	// immediately would never read any deposits.
+	LogQueryTimeout time.Duration `mapstructure:"log-query-timeout"`
+	// LogQueryChunkSize is the maximum number of blocks covered by a single
+	// deposit log query. Larger ranges, e.g. during catch-up, are split into
+	// several queries, since strict execution clients reject eth_getLogs
+	// requests over large ranges.
+	LogQueryChunkSize uint64 `mapstructure:"log-query-chunk-size"`
+	// HaltOnPanic stops deposit processing when handling a block event
+	// panics, reporting the service as unhealthy, instead of re-subscribing
+	// to the block feed and carrying on with the next event.
+	HaltOnPanic bool `mapstructure:"halt-on-panic"`
+	// StalenessThreshold is how long the service may go without reading any
+	// deposit logs before it reports itself as unhealthy. Zero disables the
+	// check.
+	StalenessThreshold time.Duration `mapstructure:"staleness-threshold"`
+}
+
+// DefaultConfig returns the default deposit service configuration.
+func DefaultConfig() Config {
+	return Config{
+		LogQueryTimeout:   defaultLogQueryTimeout,
+		LogQueryChunkSize: defaultLogQueryChunkSize,
+	}
+}
@@ -21,8 +21,10 @@
 package deposit
 
 import (
+	stdbytes "bytes"
 	"context"
 	"errors"
+	"math/big"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -39,6 +41,10 @@ type WrappedBeaconDepositContract[
 ] struct {
 	// BeaconDepositContract is a pointer to the codegen ABI binding.
 	BeaconDepositContract
+	// address is the address of the deposit contract.
+	address common.ExecutionAddress
+	// caller is used to read the code deployed at the address.
+	caller bind.ContractCaller
 }
 
 // NewWrappedBeaconDepositContract creates a new BeaconDepositContract.
@@ -67,6 +73,8 @@ func NewWrappedBeaconDepositContract[
 		WithdrawalCredentialsT,
 	]{
 		BeaconDepositContract: *contract,
+		address:               address,
+		caller:                client,
 	}, nil
 }
 
@@ -77,12 +85,26 @@ func (dc *WrappedBeaconDepositContract[
 ]) ReadDeposits(
 	ctx context.Context,
 	blkNum math.U64,
+) ([]DepositT, error) {
+	return dc.ReadDepositsInRange(ctx, blkNum, blkNum)
+}
+
+// ReadDepositsInRange reads deposits from the deposit contract for the
+// inclusive range of blocks [fromBlk, toBlk]. Deposits are returned in the
+// order they were emitted by the contract.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) ReadDepositsInRange(
+	ctx context.Context,
+	fromBlk math.U64,
+	toBlk math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   uint64(blkNum),
-			End:     (*uint64)(&blkNum),
+			Start:   uint64(fromBlk),
+			End:     (*uint64)(&toBlk),
 		},
 	)
 	if err != nil {
@@ -91,16 +113,97 @@ func (dc *WrappedBeaconDepositContract[
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
-		var d DepositT
-		deposits = append(deposits, d.New(
-			bytes.ToBytes48(logs.Event.Pubkey),
-			WithdrawalCredentialsT(
-				bytes.ToBytes32(logs.Event.Credentials)),
-			math.U64(logs.Event.Amount),
-			bytes.ToBytes96(logs.Event.Signature),
-			logs.Event.Index,
-		))
+		deposits = append(deposits, dc.newDeposit(logs.Event))
+	}
+
+	return deposits, nil
+}
+
+// ReadDepositsByBlock reads deposits from the deposit contract for the
+// inclusive range of blocks [fromBlk, toBlk], keyed by the number of the
+// block that emitted them.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) ReadDepositsByBlock(
+	ctx context.Context,
+	fromBlk math.U64,
+	toBlk math.U64,
+) (map[math.U64][]DepositT, error) {
+	logs, err := dc.FilterDeposit(
+		&bind.FilterOpts{
+			Context: ctx,
+			Start:   uint64(fromBlk),
+			End:     (*uint64)(&toBlk),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make(map[math.U64][]DepositT)
+	for logs.Next() {
+		blkNum := math.U64(logs.Event.Raw.BlockNumber)
+		deposits[blkNum] = append(
+			deposits[blkNum], dc.newDeposit(logs.Event),
+		)
 	}
 
 	return deposits, nil
 }
+
+// DepositCountAt returns the number of deposits emitted by the deposit
+// contract up to and including the given block.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) DepositCountAt(
+	ctx context.Context,
+	blkNum math.U64,
+) (uint64, error) {
+	return dc.DepositCount(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(uint64(blkNum)),
+	})
+}
+
+// newDeposit creates a deposit from a deposit event.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) newDeposit(event *BeaconDepositContractDeposit) DepositT {
+	var d DepositT
+	return d.New(
+		bytes.ToBytes48(event.Pubkey),
+		WithdrawalCredentialsT(bytes.ToBytes32(event.Credentials)),
+		math.U64(event.Amount),
+		bytes.ToBytes96(event.Signature),
+		event.Index,
+	)
+}
+
+// VerifyDeployment checks that a contract is deployed at the configured
+// address and that its code emits the deposit event. Solidity embeds the
+// topic of every emitted event in the contract code, so a contract lacking it
+// cannot be the deposit contract.
+func (dc *WrappedBeaconDepositContract[
+	DepositT,
+	WithdrawalCredentialsT,
+]) VerifyDeployment(ctx context.Context) error {
+	code, err := dc.caller.CodeAt(ctx, dc.address, nil)
+	if err != nil {
+		return err
+	} else if len(code) == 0 {
+		return ErrDepositContractNotDeployed
+	}
+
+	parsed, err := BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	topic := parsed.Events["Deposit"].ID
+	if !stdbytes.Contains(code, topic.Bytes()) {
+		return ErrDepositEventNotFound
+	}
+	return nil
+}
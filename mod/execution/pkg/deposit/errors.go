@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrLogQueryTimeout is returned when a deposit log query to the
+	// execution client does not complete within the configured timeout.
+	ErrLogQueryTimeout = errors.New("deposit log query timed out")
+	// ErrDepositContractNotDeployed is returned when there is no code at the
+	// configured deposit contract address.
+	ErrDepositContractNotDeployed = errors.New(
+		"no contract deployed at deposit contract address",
+	)
+	// ErrDepositEventNotFound is returned when the contract at the configured
+	// deposit contract address does not emit the deposit event.
+	ErrDepositEventNotFound = errors.New(
+		"deposit event not found in deposit contract code",
+	)
+	// ErrBlockFeedClosed is returned when the block feed subscription is
+	// closed while the service is still running.
+	ErrBlockFeedClosed = errors.New("block feed subscription closed")
+	// ErrBlockEventPanic is returned when handling a block event panics.
+	ErrBlockEventPanic = errors.New("panic while handling block event")
+	// ErrDepositFetcherHalted is reported by Status when deposit processing
+	// was stopped after a panic.
+	ErrDepositFetcherHalted = errors.New("deposit fetcher halted")
+	// ErrBlockFeedUnavailable is reported by Status when re-subscribing to
+	// the block feed failed repeatedly.
+	ErrBlockFeedUnavailable = errors.New("block feed unavailable")
+	// ErrDepositLogsStale is reported by Status when no deposit logs were
+	// read for longer than the configured staleness threshold.
+	ErrDepositLogsStale = errors.New("deposit logs stale")
+)
@@ -47,3 +47,33 @@ func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
 		strconv.FormatUint(uint64(blockNum), 10),
 	)
 }
+
+// markDepositsHandled increments the counters for the deposits fetched,
+// enqueued and skipped as duplicates for a block or range of blocks.
+func (m *metrics) markDepositsHandled(result depositResult) {
+	//#nosec:G701 // the counts are never negative.
+	m.sink.AddCounter(
+		"beacon_kit.execution.deposit.deposits_fetched",
+		uint64(result.fetched),
+	)
+	//#nosec:G701 // the counts are never negative.
+	m.sink.AddCounter(
+		"beacon_kit.execution.deposit.deposits_enqueued",
+		uint64(result.enqueued),
+	)
+	//#nosec:G701 // the counts are never negative.
+	m.sink.AddCounter(
+		"beacon_kit.execution.deposit.deposits_duplicate",
+		uint64(result.duplicates),
+	)
+}
+
+// setPendingDeposits sets the gauge for the number of deposits waiting in the
+// deposit store.
+func (m *metrics) setPendingDeposits(count uint64) {
+	//#nosec:G701 // the deposit queue will never exceed int64 max.
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.pending_deposits",
+		int64(count),
+	)
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlockContract is a deposit contract whose deposits can be attributed to
+// the blocks that emitted them.
+type BlockContract[DepositT any] interface {
+	Contract[DepositT]
+	// ReadDepositsByBlock reads deposits from the deposit contract for the
+	// inclusive range of blocks [fromBlock, toBlock], keyed by the number of
+	// the block that emitted them.
+	ReadDepositsByBlock(
+		ctx context.Context,
+		fromBlock math.U64,
+		toBlock math.U64,
+	) (map[math.U64][]DepositT, error)
+	// DepositCountAt returns the number of deposits emitted by the deposit
+	// contract up to and including the given block.
+	DepositCountAt(ctx context.Context, blockNumber math.U64) (uint64, error)
+}
+
+// reindexable is a deposit that can be recreated with a different index.
+type reindexable[DepositT, WithdrawalCredentialsT any] interface {
+	Deposit[DepositT, WithdrawalCredentialsT]
+	GetPubkey() crypto.BLSPubkey
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	GetAmount() math.Gwei
+	GetSignature() crypto.BLSSignature
+}
+
+// MultiContract reads deposits from several contracts, for deployments in
+// which more than one contract emits deposit events.
+//
+// Every contract numbers its deposits from zero, so the deposits are
+// re-indexed into a single sequence: they are ordered by the block that
+// emitted them, then by the position of their contract, then by their index
+// in that contract. As the sequence only depends on the chain, every node
+// assigns the same index to every deposit, however it splits the block
+// ranges it reads.
+type MultiContract[
+	DepositT reindexable[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT any,
+] struct {
+	// contracts are the contracts that deposits are read from.
+	contracts []BlockContract[DepositT]
+}
+
+// NewMultiContract creates a new MultiContract reading from the given
+// contracts.
+func NewMultiContract[
+	DepositT reindexable[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT any,
+](
+	contracts ...BlockContract[DepositT],
+) *MultiContract[DepositT, WithdrawalCredentialsT] {
+	return &MultiContract[DepositT, WithdrawalCredentialsT]{
+		contracts: contracts,
+	}
+}
+
+// ReadDeposits reads the deposits of all contracts for the given block.
+func (mc *MultiContract[DepositT, WithdrawalCredentialsT]) ReadDeposits(
+	ctx context.Context,
+	blkNum math.U64,
+) ([]DepositT, error) {
+	return mc.ReadDepositsInRange(ctx, blkNum, blkNum)
+}
+
+// ReadDepositsInRange reads the deposits of all contracts for the inclusive
+// range of blocks [fromBlk, toBlk], re-indexed into a single sequence. If
+// reading from any contract fails, no deposits are returned, so that the
+// range is retried as a whole.
+func (mc *MultiContract[
+	DepositT, WithdrawalCredentialsT,
+]) ReadDepositsInRange(
+	ctx context.Context,
+	fromBlk math.U64,
+	toBlk math.U64,
+) ([]DepositT, error) {
+	// The first deposit in the range follows every deposit emitted by any
+	// contract before it.
+	next, err := mc.depositCountBefore(ctx, fromBlk)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		byContract = make([]map[math.U64][]DepositT, len(mc.contracts))
+		blkNums    = make([]math.U64, 0)
+	)
+	for i, contract := range mc.contracts {
+		byContract[i], err = contract.ReadDepositsByBlock(ctx, fromBlk, toBlk)
+		if err != nil {
+			return nil, err
+		}
+		for blkNum := range byContract[i] {
+			blkNums = append(blkNums, blkNum)
+		}
+	}
+	slices.Sort(blkNums)
+	blkNums = slices.Compact(blkNums)
+
+	deposits := make([]DepositT, 0)
+	for _, blkNum := range blkNums {
+		for _, byBlock := range byContract {
+			blkDeposits := byBlock[blkNum]
+			sortByIndex(blkDeposits)
+			for _, d := range blkDeposits {
+				deposits = append(
+					deposits, reindex[DepositT, WithdrawalCredentialsT](d, next),
+				)
+				next++
+			}
+		}
+	}
+	return deposits, nil
+}
+
+// VerifyDeployment verifies the deployment of all contracts.
+func (mc *MultiContract[DepositT, WithdrawalCredentialsT]) VerifyDeployment(
+	ctx context.Context,
+) error {
+	for _, contract := range mc.contracts {
+		if err := contract.VerifyDeployment(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// depositCountBefore returns the number of deposits emitted by all contracts
+// before the given block.
+func (mc *MultiContract[
+	DepositT, WithdrawalCredentialsT,
+]) depositCountBefore(
+	ctx context.Context,
+	blkNum math.U64,
+) (uint64, error) {
+	if blkNum == 0 {
+		return 0, nil
+	}
+
+	var count uint64
+	for _, contract := range mc.contracts {
+		contractCount, err := contract.DepositCountAt(ctx, blkNum-1)
+		if err != nil {
+			return 0, err
+		}
+		count += contractCount
+	}
+	return count, nil
+}
+
+// reindex returns a copy of the deposit with the given index.
+func reindex[
+	DepositT reindexable[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT any,
+](d DepositT, index uint64) DepositT {
+	return d.New(
+		d.GetPubkey(),
+		d.GetWithdrawalCredentials(),
+		d.GetAmount(),
+		d.GetSignature(),
+		index,
+	)
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a deposit identified by its amount.
+type testDeposit struct {
+	amount math.Gwei
+	index  uint64
+}
+
+func (d *testDeposit) New(
+	_ crypto.BLSPubkey,
+	_ [32]byte,
+	amount math.U64,
+	_ crypto.BLSSignature,
+	index uint64,
+) *testDeposit {
+	return &testDeposit{amount: amount, index: index}
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return d.index
+}
+
+func (d *testDeposit) GetPubkey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{}
+}
+
+func (d *testDeposit) GetWithdrawalCredentials() [32]byte {
+	return [32]byte{}
+}
+
+func (d *testDeposit) GetAmount() math.Gwei {
+	return d.amount
+}
+
+func (d *testDeposit) GetSignature() crypto.BLSSignature {
+	return crypto.BLSSignature{}
+}
+
+// testContract is a deposit contract whose deposits are keyed by the block
+// that emitted them. Deposits are numbered from zero in block order.
+type testContract struct {
+	deposits map[math.U64][]*testDeposit
+}
+
+func (c *testContract) ReadDeposits(
+	ctx context.Context,
+	blkNum math.U64,
+) ([]*testDeposit, error) {
+	return c.ReadDepositsInRange(ctx, blkNum, blkNum)
+}
+
+func (c *testContract) ReadDepositsInRange(
+	ctx context.Context,
+	fromBlk, toBlk math.U64,
+) ([]*testDeposit, error) {
+	byBlock, err := c.ReadDepositsByBlock(ctx, fromBlk, toBlk)
+	if err != nil {
+		return nil, err
+	}
+	deposits := make([]*testDeposit, 0)
+	for blkNum := fromBlk; blkNum <= toBlk; blkNum++ {
+		deposits = append(deposits, byBlock[blkNum]...)
+	}
+	return deposits, nil
+}
+
+func (c *testContract) ReadDepositsByBlock(
+	_ context.Context,
+	fromBlk, toBlk math.U64,
+) (map[math.U64][]*testDeposit, error) {
+	deposits := make(map[math.U64][]*testDeposit)
+	for blkNum, blkDeposits := range c.deposits {
+		if blkNum >= fromBlk && blkNum <= toBlk {
+			deposits[blkNum] = blkDeposits
+		}
+	}
+	return deposits, nil
+}
+
+func (c *testContract) DepositCountAt(
+	_ context.Context,
+	blkNum math.U64,
+) (uint64, error) {
+	var count uint64
+	for n, blkDeposits := range c.deposits {
+		if n <= blkNum {
+			count += uint64(len(blkDeposits))
+		}
+	}
+	return count, nil
+}
+
+func (c *testContract) VerifyDeployment(context.Context) error {
+	return nil
+}
+
+// TestMultiContractReindexes tests that the deposits of several contracts
+// are re-indexed into one sequence, ordered by block and then by contract,
+// however the block range is split.
+func TestMultiContractReindexes(t *testing.T) {
+	mc := deposit.NewMultiContract[*testDeposit, [32]byte](
+		&testContract{deposits: map[math.U64][]*testDeposit{
+			1: {{amount: 10, index: 0}},
+			3: {{amount: 11, index: 1}},
+		}},
+		&testContract{deposits: map[math.U64][]*testDeposit{
+			1: {{amount: 20, index: 0}},
+			2: {{amount: 21, index: 1}},
+		}},
+	)
+	expected := []*testDeposit{
+		{amount: 10, index: 0},
+		{amount: 20, index: 1},
+		{amount: 21, index: 2},
+		{amount: 11, index: 3},
+	}
+
+	deposits, err := mc.ReadDepositsInRange(context.Background(), 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, expected, deposits)
+
+	deposits = make([]*testDeposit, 0)
+	for blkNum := math.U64(0); blkNum <= 3; blkNum++ {
+		blkDeposits, readErr := mc.ReadDeposits(context.Background(), blkNum)
+		require.NoError(t, readErr)
+		deposits = append(deposits, blkDeposits...)
+	}
+	require.Equal(t, expected, deposits)
+}
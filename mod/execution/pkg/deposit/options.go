@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// defaultEth1FollowDistance is the default follow distance for Ethereum
+	// 1.0 blocks, matching the testnet chain spec.
+	defaultEth1FollowDistance = 1
+)
+
+// options holds the optional settings of the deposit service.
+type options[DepositT any] struct {
+	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
+	eth1FollowDistance math.U64
+	// retryInterval is the interval at which failed blocks are retried.
+	retryInterval time.Duration
+	// cfg is the configuration for the deposit service.
+	cfg Config
+	// verifySignature checks the signature of a deposit, if set.
+	verifySignature func(DepositT) error
+}
+
+// defaultOptions returns the options used when none are supplied.
+func defaultOptions[DepositT any]() *options[DepositT] {
+	return &options[DepositT]{
+		eth1FollowDistance: defaultEth1FollowDistance,
+		retryInterval:      defaultRetryInterval,
+		cfg:                DefaultConfig(),
+	}
+}
+
+// Option is a functional option for the deposit service.
+type Option[DepositT any] func(*options[DepositT]) error
+
+// WithEth1FollowDistance sets the follow distance for Ethereum 1.0 blocks.
+func WithEth1FollowDistance[DepositT any](distance math.U64) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		o.eth1FollowDistance = distance
+		return nil
+	}
+}
+
+// WithRetryInterval sets the interval at which blocks whose deposits failed
+// to be fetched are retried.
+func WithRetryInterval[DepositT any](interval time.Duration) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		if interval <= 0 {
+			return errors.Newf("retry interval must be positive: %s", interval)
+		}
+		o.retryInterval = interval
+		return nil
+	}
+}
+
+// WithConfig applies the given configuration, as loaded from the node's
+// config file. Unset values keep their defaults.
+func WithConfig[DepositT any](cfg Config) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		for _, opt := range []Option[DepositT]{
+			WithLogQueryTimeout[DepositT](cfg.LogQueryTimeout),
+			WithLogQueryChunkSize[DepositT](cfg.LogQueryChunkSize),
+			WithHaltOnPanic[DepositT](cfg.HaltOnPanic),
+			WithStalenessThreshold[DepositT](cfg.StalenessThreshold),
+		} {
+			if err := opt(o); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// WithLogQueryTimeout sets the timeout for a single deposit log query. A zero
+// timeout keeps the default, so that configs predating the setting still work.
+func WithLogQueryTimeout[DepositT any](
+	timeout time.Duration,
+) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		switch {
+		case timeout < 0:
+			return errors.Newf(
+				"log query timeout must not be negative: %s", timeout,
+			)
+		case timeout > 0:
+			o.cfg.LogQueryTimeout = timeout
+		}
+		return nil
+	}
+}
+
+// WithLogQueryChunkSize sets the maximum number of blocks covered by a single
+// deposit log query. A zero size keeps the default, so that configs predating
+// the setting still work.
+func WithLogQueryChunkSize[DepositT any](size uint64) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		if size > 0 {
+			o.cfg.LogQueryChunkSize = size
+		}
+		return nil
+	}
+}
+
+// WithHaltOnPanic sets whether deposit processing stops when handling a block
+// event panics. Otherwise the service re-subscribes to the block feed and
+// carries on with the next event.
+func WithHaltOnPanic[DepositT any](halt bool) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		o.cfg.HaltOnPanic = halt
+		return nil
+	}
+}
+
+// WithStalenessThreshold sets how long the service may go without reading
+// any deposit logs before it reports itself as unhealthy. A zero threshold
+// disables the check, which is the default.
+func WithStalenessThreshold[DepositT any](
+	threshold time.Duration,
+) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		if threshold < 0 {
+			return errors.Newf(
+				"staleness threshold must not be negative: %s", threshold,
+			)
+		}
+		o.cfg.StalenessThreshold = threshold
+		return nil
+	}
+}
+
+// WithSignatureVerifier enables checking the signature of every deposit read
+// from the deposit contract with the given function. Deposits that fail the
+// check are still enqueued, since the state transition must process them
+// all, but are reported, as they will not create a validator. Signatures are
+// not checked by default.
+func WithSignatureVerifier[DepositT any](
+	verify func(DepositT) error,
+) Option[DepositT] {
+	return func(o *options[DepositT]) error {
+		if verify == nil {
+			return errors.New("signature verifier must not be nil")
+		}
+		o.verifySignature = verify
+		return nil
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"cmp"
+	"slices"
+)
+
+// indexed is a deposit that carries its index in the deposit contract.
+type indexed interface {
+	GetIndex() uint64
+}
+
+// sortByIndex sorts the deposits by index in place. The log ordering of
+// eth_getLogs is not guaranteed, so deposits must be sorted before they are
+// enqueued.
+func sortByIndex[DepositT indexed](deposits []DepositT) {
+	slices.SortStableFunc(deposits, func(a, b DepositT) int {
+		return cmp.Compare(a.GetIndex(), b.GetIndex())
+	})
+}
+
+// findIndexGap returns the position of the first deposit whose index does
+// not directly follow that of its predecessor in a sorted slice, or -1 if
+// the indices are contiguous.
+func findIndexGap[DepositT indexed](deposits []DepositT) int {
+	for i := 1; i < len(deposits); i++ {
+		if deposits[i].GetIndex() != deposits[i-1].GetIndex()+1 {
+			return i
+		}
+	}
+	return -1
+}
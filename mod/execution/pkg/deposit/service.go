@@ -22,7 +22,11 @@ package deposit
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -37,14 +41,23 @@ type Service[
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 	ExecutionPayloadT ExecutionPayload,
 	SubscriptionT interface {
+		Err() <-chan error
 		Unsubscribe()
 	},
 	WithdrawalCredentialsT any,
 ] struct {
 	// logger is used for logging information and errors.
 	logger log.Logger[any]
-	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
-	eth1FollowDistance math.U64
+	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks. It
+	// can be changed at runtime with SetEth1FollowDistance.
+	eth1FollowDistance atomic.Uint64
+	// retryInterval is the interval at which failed blocks are retried.
+	retryInterval time.Duration
+	// cfg is the configuration for the deposit service.
+	cfg Config
+	// verifySignature checks the signature of every deposit read from the
+	// deposit contract, if set.
+	verifySignature func(DepositT) error
 	// dc is the contract interface for interacting with the deposit contract.
 	dc Contract[DepositT]
 	// ds is the deposit store that stores deposits.
@@ -60,12 +73,41 @@ type Service[
 	]
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
+	// failedBlocksMu protects failedBlocks, which both the block feed and
+	// the catch-up fetcher update.
+	failedBlocksMu sync.Mutex
 	// failedBlocks is a map of blocks that failed to be processed to be
 	// retried.
 	failedBlocks map[math.U64]struct{}
+	// nextDepositIndex is one past the highest deposit index enqueued by the
+	// service, or zero if none has been enqueued yet.
+	nextDepositIndex atomic.Uint64
+	// lastProcessedLogBlock is the highest execution block whose deposit
+	// logs were read and stored, or zero if there is none yet.
+	lastProcessedLogBlock atomic.Uint64
+	// lastLogsReadAt is the unix time in nanoseconds at which deposit logs
+	// were last read and stored, or at which the service was started.
+	lastLogsReadAt atomic.Int64
+	// stopCh is closed to signal the service goroutines to exit.
+	stopCh chan struct{}
+	// stopOnce ensures stopCh is only closed once.
+	stopOnce sync.Once
+	// wg tracks the goroutines spawned by the service.
+	wg sync.WaitGroup
+	// feedFailures is the number of consecutive block feed subscription
+	// failures, reset once a block event is received again.
+	feedFailures atomic.Uint64
+	// feedErrMu protects feedErr.
+	feedErrMu sync.RWMutex
+	// feedErr is the latest block feed subscription failure.
+	feedErr error
+	// halted is set once deposit processing was stopped after a panic.
+	halted atomic.Bool
 }
 
-// NewService creates a new instance of the Service struct.
+// NewService creates a new instance of the Service struct. Optional settings
+// default to DefaultConfig and the testnet chain spec when not supplied. It
+// fails if an option is invalid.
 func NewService[
 	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockT BeaconBlock[DepositT, BeaconBlockBodyT, ExecutionPayloadT],
@@ -76,50 +118,195 @@ func NewService[
 	DepositStoreT Store[DepositT],
 	ExecutionPayloadT ExecutionPayload,
 	SubscriptionT interface {
+		Err() <-chan error
 		Unsubscribe()
 	},
 	WithdrawalCredentialsT any,
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 ](
-	logger log.Logger[any],
-	eth1FollowDistance math.U64,
-	telemetrySink TelemetrySink,
-	ds Store[DepositT],
-	dc Contract[DepositT],
 	feed BlockFeed[
 		DepositT, BeaconBlockBodyT, BeaconBlockT, BlockEventT,
 		ExecutionPayloadT, SubscriptionT,
 	],
-) *Service[
+	logger log.Logger[any],
+	telemetrySink TelemetrySink,
+	ds Store[DepositT],
+	dc Contract[DepositT],
+	opts ...Option[DepositT],
+) (*Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
 	ExecutionPayloadT, SubscriptionT, WithdrawalCredentialsT,
-] {
-	return &Service[
+], error) {
+	o := defaultOptions[DepositT]()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, errors.Wrap(err, "failed to apply option")
+		}
+	}
+
+	s := &Service[
 		BeaconBlockT, BeaconBlockBodyT, BlockEventT, DepositT,
 		ExecutionPayloadT, SubscriptionT,
 		WithdrawalCredentialsT,
 	]{
-		feed:               feed,
-		logger:             logger,
-		eth1FollowDistance: eth1FollowDistance,
-		metrics:            newMetrics(telemetrySink),
-		dc:                 dc,
-		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
+		feed:            feed,
+		logger:          logger,
+		retryInterval:   o.retryInterval,
+		cfg:             o.cfg,
+		verifySignature: o.verifySignature,
+		metrics:         newMetrics(telemetrySink),
+		dc:              dc,
+		ds:              ds,
+		failedBlocks:    make(map[math.Slot]struct{}),
+		stopCh:          make(chan struct{}),
 	}
+	s.eth1FollowDistance.Store(uint64(o.eth1FollowDistance))
+	return s, nil
 }
 
-// Start starts the service and begins processing block events.
+// Start verifies the deposit contract, then starts the service and begins
+// processing block events. The execution client must be started beforehand.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
 ]) Start(ctx context.Context) error {
+	// Make sure deposits are read from the right contract, since a
+	// misconfigured contract silently yields no deposits at all.
+	if err := s.dc.VerifyDeployment(ctx); err != nil {
+		return errors.Wrap(err, "failed to verify deposit contract")
+	}
+
+	// Deposit logs are only stale once the service has run for a while.
+	s.lastLogsReadAt.Store(time.Now().UnixNano())
+
+	// Derive a context that is cancelled on Stop, so that a shutdown aborts
+	// in-flight deposit queries instead of waiting for them to complete.
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		select {
+		case <-ctx.Done():
+		case <-s.stopCh:
+		}
+	}()
+
+	//nolint:mnd // 2 go-routines.
+	s.wg.Add(2)
 	go s.depositFetcher(ctx)
 	go s.depositCatchupFetcher(ctx)
 	return nil
 }
 
+// Stop signals the service goroutines to exit, aborting any in-flight
+// deposit query, and waits for them to do so. The wait is bounded by the
+// given context.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) Stop(ctx context.Context) error {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SetEth1FollowDistance changes the follow distance for Ethereum 1.0 blocks
+// at runtime. It takes effect from the next finalized block on. The distance
+// must be at least 1.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) SetEth1FollowDistance(distance math.U64) error {
+	if distance == 0 {
+		return errors.New("eth1 follow distance must be at least 1")
+	}
+
+	previous := s.eth1FollowDistance.Swap(uint64(distance))
+	s.logger.Info(
+		"Updated eth1 follow distance",
+		"previous", previous, "current", distance,
+	)
+	return nil
+}
+
+// Status returns an error if the service cannot receive block events, i.e.
+// if re-subscribing to the block feed failed repeatedly, if deposit
+// processing was halted after a panic, or if no deposit logs were read for
+// longer than the staleness threshold. It returns nil once block events are
+// received again.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) Status() error {
+	if s.halted.Load() {
+		s.feedErrMu.RLock()
+		defer s.feedErrMu.RUnlock()
+		return errors.Wrap(ErrDepositFetcherHalted, s.feedErr.Error())
+	}
+
+	if err := s.checkStaleness(); err != nil {
+		return err
+	}
+
+	failures := s.feedFailures.Load()
+	if failures < maxBlockFeedFailures {
+		return nil
+	}
+
+	s.feedErrMu.RLock()
+	defer s.feedErrMu.RUnlock()
+	return errors.Wrapf(
+		ErrBlockFeedUnavailable,
+		"%d consecutive failures, last: %v", failures, s.feedErr,
+	)
+}
+
+// checkStaleness returns an error if no deposit logs were read for longer
+// than the staleness threshold, if one is set.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) checkStaleness() error {
+	threshold := s.cfg.StalenessThreshold
+	lastReadAt := s.lastLogsReadAt.Load()
+	if threshold == 0 || lastReadAt == 0 {
+		return nil
+	}
+	if since := time.Since(time.Unix(0, lastReadAt)); since > threshold {
+		return errors.Wrapf(
+			ErrDepositLogsStale, "no deposit logs read for %s", since,
+		)
+	}
+	return nil
+}
+
+// LastProcessedLogBlock returns the number of the highest execution block
+// whose deposit logs were read and stored, or zero if there is none yet.
+// Blocks below it whose logs could not be read are retried in the
+// background until they succeed.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) LastProcessedLogBlock() math.U64 {
+	return math.U64(s.lastProcessedLogBlock.Load())
+}
+
 // Name returns the name of the service.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
@@ -128,3 +315,18 @@ func (s *Service[
 ]) Name() string {
 	return "deposit-handler"
 }
+
+// ContainsDepositIndex reports whether the deposit with the given index has
+// been enqueued, including deposits since processed and pruned from the
+// deposit store. Indices beyond the highest one enqueued by the service are
+// answered without a store lookup.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) ContainsDepositIndex(idx uint64) (bool, error) {
+	if next := s.nextDepositIndex.Load(); next > 0 && idx >= next {
+		return false, nil
+	}
+	return s.ds.ContainsDepositIndex(idx)
+}
@@ -22,33 +22,157 @@ package deposit
 
 import (
 	"context"
+	"runtime/debug"
+	"slices"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the default interval at which failed blocks
+	// are retried.
+	defaultRetryInterval = 20 * time.Second
+	// minResubscribeDelay is the delay before re-subscribing to the block
+	// feed after its first failure. It doubles with every further failure.
+	minResubscribeDelay = time.Second
+	// maxResubscribeDelay bounds the delay before re-subscribing to the
+	// block feed.
+	maxResubscribeDelay = 30 * time.Second
+	// maxBlockFeedFailures is the number of consecutive block feed failures
+	// after which the service reports itself as unhealthy.
+	maxBlockFeedFailures = 5
+)
 
-// depositFetcher processes a deposit event.
+// depositFetcher processes a deposit event. If the block feed subscription
+// fails, it re-subscribes with an exponential backoff, so that deposit
+// processing does not silently stop.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
 ]) depositFetcher(ctx context.Context) {
+	defer s.wg.Done()
+	delay := minResubscribeDelay
+	for {
+		err := s.safeConsumeBlockFeed(ctx)
+		if err == nil {
+			return
+		}
+
+		s.feedErrMu.Lock()
+		s.feedErr = err
+		s.feedErrMu.Unlock()
+		if s.cfg.HaltOnPanic && errors.Is(err, ErrBlockEventPanic) {
+			s.halted.Store(true)
+			s.logger.Error("Halted deposit processing after panic")
+			return
+		}
+		// Start over with the shortest delay if the previous subscription
+		// delivered events before failing.
+		failures := s.feedFailures.Add(1)
+		if failures == 1 {
+			delay = minResubscribeDelay
+		}
+		s.logger.Warn(
+			"Block feed subscription failed, re-subscribing",
+			"error", err,
+			"num_failures", failures,
+			"retry_in", delay.String(),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopCh:
+			return
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxResubscribeDelay)
+	}
+}
+
+// safeConsumeBlockFeed runs consumeBlockFeed, turning a panic while handling
+// a block event into an error. The subscription is released either way.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) safeConsumeBlockFeed(ctx context.Context) error {
+	var err error
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err = errors.Wrapf(ErrBlockEventPanic, "%v", r)
+				s.logger.Error(
+					"Recovered from panic while handling block event",
+					"panic", r,
+					"stack", string(debug.Stack()),
+				)
+			}
+		}()
+		err = s.consumeBlockFeed(ctx)
+	}()
+	return err
+}
+
+// consumeBlockFeed subscribes to the block feed and processes its events
+// until the service stops, in which case it returns nil, or the subscription
+// fails.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) consumeBlockFeed(ctx context.Context) error {
 	ch := make(chan BlockEventT)
 	sub := s.feed.Subscribe(ch)
 	defer sub.Unsubscribe()
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case event := <-ch:
+			return nil
+		case <-s.stopCh:
+			return nil
+		case err := <-sub.Err():
+			// The error channel is closed without an error when the
+			// subscription ends.
+			if err == nil {
+				err = ErrBlockFeedClosed
+			}
+			return err
+		case event, ok := <-ch:
+			if !ok {
+				return ErrBlockFeedClosed
+			}
+			if failures := s.feedFailures.Swap(0); failures > 0 {
+				s.logger.Info(
+					"Recovered block feed subscription",
+					"num_failures", failures,
+				)
+			}
 			if event.Is(events.BeaconBlockFinalized) {
 				blockNum := event.Data().
-					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+					GetBody().GetExecutionPayload().GetNumber() -
+					math.U64(s.eth1FollowDistance.Load())
+				result, err := s.fetchAndStoreDeposits(ctx, blockNum)
+				if err != nil {
+					s.logger.Error(
+						"Failed to handle deposits",
+						"block", blockNum, "error", err,
+					)
+				} else if result.fetched > 0 {
+					s.logger.Info(
+						"Found deposits on execution layer",
+						"block", blockNum,
+						"deposits", result.fetched,
+						"enqueued", result.enqueued,
+						"duplicates", result.duplicates,
+					)
+				}
+				s.metrics.markDepositsHandled(result)
+				s.reportPendingDeposits()
 			}
 		}
 	}
@@ -61,54 +185,338 @@ func (s *Service[
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
 ]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.retryInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.stopCh:
+			return
 		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
+			ranges, numBlocks := s.failedBlockRanges()
+			if numBlocks == 0 {
 				continue
 			}
 			s.logger.Warn(
 				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"num_blocks", numBlocks,
 			)
 
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+			// Fetch deposits for blocks that failed to be processed, batching
+			// contiguous blocks into a single range query.
+			for _, r := range ranges {
+				s.fetchAndStoreDepositsInRange(ctx, r[0], r[1])
 			}
+			s.reportPendingDeposits()
 		}
 	}
 }
 
+// depositResult summarizes the deposits handled for a block.
+type depositResult struct {
+	// fetched is the number of deposits read from the block.
+	fetched int
+	// enqueued is the number of deposits added to the deposit store.
+	enqueued int
+	// duplicates is the number of deposits skipped since they had already
+	// been enqueued, possibly processed and pruned since, or appeared twice
+	// in the block.
+	duplicates int
+}
+
+// fetchAndStoreDeposits fetches the deposits of the given block and enqueues
+// those not in the deposit store yet. A non-nil error means the block was not
+// fully handled, in which case it is retried later.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
 	ExecutionPayloadT, SubscriptionT,
 	WithdrawalCredentialsT, DepositT,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+]) fetchAndStoreDeposits(
+	ctx context.Context,
+	blockNum math.U64,
+) (depositResult, error) {
+	var result depositResult
+	deposits, err := s.readDepositsInRange(ctx, blockNum, blockNum)
 	if err != nil {
 		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
+		s.markFailedBlocks(blockNum, blockNum)
+		return result, errors.Wrap(err, "failed to read deposits")
+	}
+	result.fetched = len(deposits)
+
+	// Do not enqueue deposits once the service is shutting down.
+	if err = ctx.Err(); err != nil {
+		s.markFailedBlocks(blockNum, blockNum)
+		return result, err
+	}
+
+	if result, err = s.enqueueNewDeposits(deposits); err != nil {
+		s.markFailedBlocks(blockNum, blockNum)
+		return result, err
+	}
+	s.markLogBlockProcessed(blockNum)
+	s.clearFailedBlocks(blockNum, blockNum)
+	return result, nil
+}
+
+// enqueueNewDeposits enqueues those of the given deposits, sorted by index,
+// that are not in the deposit store yet, and returns how many were fetched,
+// enqueued and skipped as duplicates.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) enqueueNewDeposits(
+	deposits []DepositT,
+) (depositResult, error) {
+	result := depositResult{fetched: len(deposits)}
+	newDeposits := make([]DepositT, 0, len(deposits))
+	seen := make(map[uint64]struct{}, len(deposits))
+	for _, deposit := range deposits {
+		if _, ok := seen[deposit.GetIndex()]; ok {
+			result.duplicates++
+			continue
+		}
+		seen[deposit.GetIndex()] = struct{}{}
+
+		found, err := s.ContainsDepositIndex(deposit.GetIndex())
+		if err != nil {
+			return result, errors.Wrap(err, "failed to look up deposit")
+		}
+		if found {
+			result.duplicates++
+			continue
+		}
+		newDeposits = append(newDeposits, deposit)
+	}
+
+	if err := s.ds.EnqueueDeposits(newDeposits); err != nil {
+		return result, errors.Wrap(err, "failed to store deposits")
+	}
+	result.enqueued = len(newDeposits)
+	s.trackEnqueuedDeposits(newDeposits)
+	s.checkSignatures(newDeposits)
+	return result, nil
+}
+
+// fetchAndStoreDepositsInRange fetches and stores the deposits for the
+// inclusive range of blocks [fromBlock, toBlock]. Large ranges are split into
+// chunks of at most the configured log query chunk size.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) fetchAndStoreDepositsInRange(
+	ctx context.Context,
+	fromBlock, toBlock math.U64,
+) {
+	chunkSize := math.U64(s.cfg.LogQueryChunkSize)
+	for start := fromBlock; start <= toBlock; start += chunkSize {
+		end := min(start+chunkSize-1, toBlock)
+		deposits, err := s.readDepositsInRange(ctx, start, end)
+		if err != nil {
+			s.logger.Error(
+				"Failed to read deposits",
+				"from_block", start, "to_block", end, "error", err,
+			)
+			s.metrics.markFailedToGetBlockLogs(start)
+			s.markFailedBlocks(start, end)
+			continue
+		}
+
+		// Stop without enqueueing once the service is shutting down.
+		if ctx.Err() != nil {
+			s.markFailedBlocks(start, end)
+			return
+		}
+
+		result, err := s.enqueueNewDeposits(deposits)
+		s.metrics.markDepositsHandled(result)
+		if err != nil {
+			s.logger.Error(
+				"Failed to handle deposits",
+				"from_block", start, "to_block", end, "error", err,
+			)
+			s.markFailedBlocks(start, end)
+			continue
+		}
+		if result.fetched > 0 {
+			s.logger.Info(
+				"Found deposits on execution layer",
+				"from_block", start, "to_block", end,
+				"deposits", result.fetched,
+				"enqueued", result.enqueued,
+				"duplicates", result.duplicates,
+			)
+		}
+		s.markLogBlockProcessed(end)
+		s.clearFailedBlocks(start, end)
+	}
+}
+
+// trackEnqueuedDeposits records the highest deposit index enqueued so far.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) trackEnqueuedDeposits(deposits []DepositT) {
+	for _, deposit := range deposits {
+		next := deposit.GetIndex() + 1
+		for {
+			current := s.nextDepositIndex.Load()
+			if next <= current ||
+				s.nextDepositIndex.CompareAndSwap(current, next) {
+				break
+			}
+		}
+	}
+}
+
+// checkSignatures reports the deposits whose signature fails verification,
+// if a signature verifier is set. Such deposits are enqueued regardless,
+// since the state transition must process them, but create no validator.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) checkSignatures(deposits []DepositT) {
+	if s.verifySignature == nil {
+		return
+	}
+	for _, deposit := range deposits {
+		if err := s.verifySignature(deposit); err != nil {
+			s.logger.Warn(
+				"Deposit has an invalid signature and will not create a "+
+					"validator",
+				"index", deposit.GetIndex(),
+				"error", err,
+			)
+		}
+	}
+}
+
+// reportPendingDeposits emits the number of deposits waiting in the deposit
+// store, which grows if deposits are not being consumed downstream.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) reportPendingDeposits() {
+	count, err := s.ds.PendingCount()
+	if err != nil {
+		s.logger.Error("Failed to count pending deposits", "error", err)
 		return
 	}
+	s.metrics.setPendingDeposits(count)
+}
 
-	if len(deposits) > 0 {
-		s.logger.Info(
-			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+// readDepositsInRange reads the deposits for the inclusive range of blocks
+// [fromBlock, toBlock], bounding the query by the configured log query
+// timeout so that a hanging execution client cannot stall the service. The
+// deposits are returned sorted by index.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) readDepositsInRange(
+	ctx context.Context,
+	fromBlock, toBlock math.U64,
+) ([]DepositT, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, s.cfg.LogQueryTimeout)
+	defer cancel()
+
+	deposits, err := s.dc.ReadDepositsInRange(queryCtx, fromBlock, toBlock)
+	if err != nil {
+		if errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+			return nil, errors.Wrapf(
+				ErrLogQueryTimeout, "blocks %d-%d after %s",
+				fromBlock, toBlock, s.cfg.LogQueryTimeout,
+			)
+		}
+		return nil, err
+	}
+
+	sortByIndex(deposits)
+	if i := findIndexGap(deposits); i >= 0 {
+		s.logger.Warn(
+			"Gap in deposit indices read from execution layer",
+			"from_block", fromBlock, "to_block", toBlock,
+			"index", deposits[i-1].GetIndex(),
+			"next_index", deposits[i].GetIndex(),
 		)
 	}
+	return deposits, nil
+}
 
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
+// markFailedBlocks marks the inclusive range of blocks [fromBlock, toBlock]
+// as failed so they are retried.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) markFailedBlocks(fromBlock, toBlock math.U64) {
+	s.failedBlocksMu.Lock()
+	defer s.failedBlocksMu.Unlock()
+	for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
 		s.failedBlocks[blockNum] = struct{}{}
-		return
 	}
+}
 
-	delete(s.failedBlocks, blockNum)
+// clearFailedBlocks removes the inclusive range of blocks
+// [fromBlock, toBlock] from the failed blocks once they were handled.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) clearFailedBlocks(fromBlock, toBlock math.U64) {
+	s.failedBlocksMu.Lock()
+	defer s.failedBlocksMu.Unlock()
+	for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
+		delete(s.failedBlocks, blockNum)
+	}
+}
+
+// failedBlockRanges returns the failed blocks grouped into sorted, inclusive
+// ranges of contiguous block numbers, along with the number of failed blocks.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) failedBlockRanges() ([][2]math.U64, int) {
+	s.failedBlocksMu.Lock()
+	blockNums := make([]math.U64, 0, len(s.failedBlocks))
+	for blockNum := range s.failedBlocks {
+		blockNums = append(blockNums, blockNum)
+	}
+	s.failedBlocksMu.Unlock()
+	slices.Sort(blockNums)
+
+	ranges := make([][2]math.U64, 0)
+	for _, blockNum := range blockNums {
+		if n := len(ranges); n > 0 && ranges[n-1][1]+1 == blockNum {
+			ranges[n-1][1] = blockNum
+			continue
+		}
+		ranges = append(ranges, [2]math.U64{blockNum, blockNum})
+	}
+	return ranges, len(blockNums)
+}
+
+// markLogBlockProcessed records that the deposit logs of the given block
+// were read and stored, unless a higher block was already recorded.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BlockEventT,
+	ExecutionPayloadT, SubscriptionT,
+	WithdrawalCredentialsT, DepositT,
+]) markLogBlockProcessed(blockNum math.U64) {
+	s.lastLogsReadAt.Store(time.Now().UnixNano())
+	for {
+		last := s.lastProcessedLogBlock.Load()
+		if blockNum.Unwrap() <= last ||
+			s.lastProcessedLogBlock.CompareAndSwap(last, blockNum.Unwrap()) {
+			return
+		}
+	}
 }
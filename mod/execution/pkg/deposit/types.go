@@ -22,8 +22,10 @@ package deposit
 
 import (
 	"context"
+	"io"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -67,6 +69,7 @@ type BlockFeed[
 	],
 	ExecutionPayloadT ExecutionPayload,
 	SubscriptionT interface {
+		Err() <-chan error
 		Unsubscribe()
 	},
 ] interface {
@@ -85,6 +88,16 @@ type Contract[DepositT any] interface {
 		ctx context.Context,
 		blockNumber math.U64,
 	) ([]DepositT, error)
+	// ReadDepositsInRange reads deposits from the deposit contract for the
+	// inclusive range of blocks [fromBlock, toBlock].
+	ReadDepositsInRange(
+		ctx context.Context,
+		fromBlock math.U64,
+		toBlock math.U64,
+	) ([]DepositT, error)
+	// VerifyDeployment checks that the deposit contract is deployed and
+	// emits deposit events.
+	VerifyDeployment(ctx context.Context) error
 }
 
 // Deposit is an interface for deposits.
@@ -103,10 +116,21 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 
 // Store defines the interface for managing deposit operations.
 type Store[DepositT any] interface {
-	// Prune prunes the deposit store of [start, end)
-	Prune(index uint64, numPrune uint64) error
+	// Prune prunes numPrune deposits starting at index from the deposit
+	// store and returns the number of deposits pruned.
+	Prune(index uint64, numPrune uint64) (uint64, error)
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// PendingCount returns the number of deposits waiting in the store.
+	PendingCount() (uint64, error)
+	// ContainsDepositIndex reports whether the deposit with the given index
+	// has been stored, including deposits since processed and pruned.
+	ContainsDepositIndex(idx uint64) (bool, error)
+	// ImportSnapshot replaces the deposits in the store with those read
+	// from a snapshot holding expectedCount deposits with the expected root.
+	ImportSnapshot(
+		r io.Reader, expectedCount uint64, expectedRoot common.Root,
+	) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -114,4 +138,10 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// AddCounter adds the given value to a counter metric identified by the
+	// provided keys.
+	AddCounter(key string, value uint64, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
 }
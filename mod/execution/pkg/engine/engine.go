@@ -50,6 +50,8 @@ type Engine[
 	logger log.Logger[any]
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// payloads caches recent payload verification outcomes.
+	payloads *payloadCache
 	// statusFeed is the status feed for the engine.
 	statusFeed *event.FeedOf[
 		asynctypes.EventID,
@@ -71,11 +73,13 @@ func New[
 		*asynctypes.Event[*service.StatusEvent],
 	],
 	telemtrySink TelemetrySink,
+	payloadCacheSize uint64,
 ) *Engine[ExecutionPayloadT, PayloadIDT] {
 	return &Engine[ExecutionPayloadT, PayloadIDT]{
 		ec:         ec,
 		logger:     logger,
 		metrics:    newEngineMetrics(telemtrySink, logger),
+		payloads:   newPayloadCache(payloadCacheSize),
 		statusFeed: statusFeed,
 	}
 }
@@ -146,7 +150,12 @@ func (ee *Engine[ExecutionPayloadT, PayloadIDT]) NotifyForkchoiceUpdate(
 		engineerrors.ErrInvalidBlockHashPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateInvalid(req.State, err)
-		return payloadID, latestValidHash, ErrBadBlockProduced
+		// The execution client rejected our view of the chain, so any
+		// cached verification outcomes may be stale.
+		ee.payloads.purge()
+		return payloadID, latestValidHash, errors.Join(
+			ErrBadBlockProduced, err,
+		)
 
 	// JSON-RPC errors are predefined and should be handled as such.
 	case jsonrpc.IsPreDefinedError(err):
@@ -196,6 +205,15 @@ func (ee *Engine[ExecutionPayloadT, PayloadIDT]) VerifyAndNotifyNewPayload(
 		return err
 	}
 
+	// If the execution client already deemed this payload invalid, reject it
+	// rather than asking again. Any other payload is always sent, since the
+	// execution client must receive the payloads it is to import.
+	blockHash := req.ExecutionPayload.GetBlockHash()
+	if ee.payloads.isInvalid(blockHash) {
+		ee.metrics.markNewPayloadCacheHit(blockHash)
+		return ErrBadBlockProduced
+	}
+
 	// Otherwise we will send the payload to the execution client.
 	lastValidHash, err := ee.ec.NewPayload(
 		ctx,
@@ -233,6 +251,7 @@ func (ee *Engine[ExecutionPayloadT, PayloadIDT]) VerifyAndNotifyNewPayload(
 			req.ExecutionPayload.GetBlockHash(),
 			req.Optimistic,
 		)
+		ee.payloads.markInvalid(blockHash)
 
 		// We want to return bad block irrespective of
 		// if we are running in optimistic mode or not.
@@ -108,6 +108,21 @@ func (em *engineMetrics) markNewPayloadInvalidPayloadStatus(
 	)
 }
 
+// markNewPayloadCacheHit increments the counter for new payload calls
+// rejected because the payload was already deemed invalid.
+func (em *engineMetrics) markNewPayloadCacheHit(
+	payloadHash common.ExecutionHash,
+) {
+	em.logger.Info(
+		"Rejecting payload previously deemed invalid",
+		"payload_block_hash", payloadHash,
+	)
+
+	em.sink.IncrementCounter(
+		"beacon_kit.execution.engine.new_payload_cache_hit",
+	)
+}
+
 // markNewPayloadJSONRPCError increments the counter for JSON-RPC errors.
 func (em *engineMetrics) markNewPayloadJSONRPCError(
 	payloadHash common.ExecutionHash,
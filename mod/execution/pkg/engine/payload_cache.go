@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// payloadCache is a bounded LRU of the block hashes of payloads the
+// execution client deemed INVALID. Valid payloads are not cached, since the
+// execution client must still be sent every payload it is to import, e.g.
+// in FinalizeBlock or after it restarted. A nil payloadCache is a valid,
+// disabled cache.
+type payloadCache struct {
+	// invalid holds the block hashes of payloads deemed invalid.
+	invalid *lru.Cache[common.ExecutionHash, struct{}]
+}
+
+// newPayloadCache creates a new payloadCache holding up to size entries. It
+// returns nil, disabling the cache, if size is zero.
+func newPayloadCache(size uint64) *payloadCache {
+	if size == 0 {
+		return nil
+	}
+
+	//#nosec:G701 // the cache size is bounded by the config.
+	invalid, err := lru.New[common.ExecutionHash, struct{}](int(size))
+	if err != nil {
+		return nil
+	}
+	return &payloadCache{invalid: invalid}
+}
+
+// isInvalid returns whether the payload with the given hash was deemed
+// invalid.
+func (c *payloadCache) isInvalid(hash common.ExecutionHash) bool {
+	if c == nil {
+		return false
+	}
+	return c.invalid.Contains(hash)
+}
+
+// markInvalid records that the payload with the given hash was deemed
+// invalid.
+func (c *payloadCache) markInvalid(hash common.ExecutionHash) {
+	if c == nil {
+		return
+	}
+	c.invalid.Add(hash, struct{}{})
+}
+
+// purge drops all cached outcomes. It is called whenever the execution
+// client reports a forkchoice update as INVALID, since the view of the chain
+// the outcomes were cached under may no longer hold.
+func (c *payloadCache) purge() {
+	if c == nil {
+		return
+	}
+	c.invalid.Purge()
+}
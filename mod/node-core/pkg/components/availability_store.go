@@ -26,11 +26,15 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/memdb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -43,6 +47,7 @@ type AvailabilityStoreInput struct {
 	depinject.In
 	AppOpts   servertypes.AppOptions
 	ChainSpec common.ChainSpec
+	Cfg       *config.Config
 	Logger    log.Logger
 }
 
@@ -52,8 +57,12 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput,
 ) (*dastore.Store[BeaconBlockBodyT], error) {
-	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
+	var db dastore.IndexDB
+	switch backend := in.Cfg.BlockChain.AvailabilityStoreBackend; backend {
+	// An empty backend is treated as disk, for configs written before the
+	// backend was configurable.
+	case blockchain.AvailabilityStoreBackendDisk, "":
+		db = filedb.NewRangeDB(
 			filedb.NewDB(
 				filedb.WithRootDirectory(
 					cast.ToString(
@@ -64,7 +73,17 @@ func ProvideAvailibilityStore[
 				filedb.WithDirectoryPermissions(os.ModePerm),
 				filedb.WithLogger(in.Logger),
 			),
-		),
+		)
+	case blockchain.AvailabilityStoreBackendMemory:
+		db = memdb.NewRangeDB()
+	default:
+		return nil, errors.Newf(
+			"unknown availability store backend: %s", backend,
+		)
+	}
+
+	return dastore.New[BeaconBlockBodyT](
+		db,
 		in.Logger.With("service", "beacon-kit.da.store"),
 		in.ChainSpec,
 	), nil
@@ -84,17 +103,15 @@ type AvailabilityPrunerInput struct {
 // framework.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) pruner.Pruner[*filedb.RangeDB] {
-	rangeDB, _ := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
-	// build the availability pruner if IndexDB is available.
+) pruner.Pruner[dastore.IndexDB] {
 	return pruner.NewPruner[
 		*BeaconBlock,
 		*BlockEvent,
-		*filedb.RangeDB,
+		dastore.IndexDB,
 		event.Subscription,
 	](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		in.AvailabilityStore.IndexDB,
 		manager.AvailabilityPrunerName,
 		in.BlockFeed,
 		dastore.BuildPruneRangeFn[
@@ -37,6 +37,7 @@ type ChainServiceInput struct {
 	BlockFeed       *BlockFeed
 	ChainSpec       common.ChainSpec
 	Cfg             *config.Config
+	DASampler       DASampler `optional:"true"`
 	DepositService  *DepositService
 	EngineClient    *EngineClient
 	ExecutionEngine *ExecutionEngine
@@ -48,10 +49,22 @@ type ChainServiceInput struct {
 	TelemetrySink   *metrics.TelemetrySink
 }
 
-// ProvideChainService is a depinject provider for the blockchain service.
+// ProvideChainService is a depinject provider for the blockchain service. A
+// data availability sampler is used in place of requiring every blob sidecar
+// to be stored if one is provided.
 func ProvideChainService(
 	in ChainServiceInput,
-) *ChainService {
+) (*ChainService, error) {
+	var opts []blockchain.Option[*BeaconBlockBody, BeaconState]
+	if in.DASampler != nil {
+		opts = append(
+			opts,
+			blockchain.WithDASampler[*BeaconBlockBody, BeaconState](
+				in.DASampler,
+			),
+		)
+	}
+
 	return blockchain.NewService[
 		*AvailabilityStore,
 		*BeaconBlock,
@@ -66,6 +79,7 @@ func ProvideChainService(
 	](
 		in.StorageBackend,
 		in.Logger.With("service", "blockchain"),
+		&in.Cfg.BlockChain,
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.LocalBuilder,
@@ -75,5 +89,6 @@ func ProvideChainService(
 		in.BlockFeed,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		opts...,
 	)
 }
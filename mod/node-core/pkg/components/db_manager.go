@@ -24,7 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -32,7 +32,7 @@ import (
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput struct {
 	depinject.In
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
+	AvailabilityPruner pruner.Pruner[dastore.IndexDB]
 	DepositPruner      pruner.Pruner[*DepositStore]
 	Logger             log.Logger
 }
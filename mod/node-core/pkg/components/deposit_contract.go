@@ -38,12 +38,19 @@ type BeaconDepositContractInput struct {
 }
 
 // ProvideBeaconDepositContract provides a beacon deposit contract through the
-// dep inject framework.
+// dep inject framework. If the chain spec lists additional deposit contracts,
+// the deposits of all contracts are read and indexed together.
 func ProvideBeaconDepositContract[
-	DepositT interfaces.Deposit[
-		crypto.BLSPubkey, crypto.BLSSignature,
-		DepositT, math.U64, WithdrawalCredentialsT,
-	],
+	DepositT interface {
+		interfaces.Deposit[
+			crypto.BLSPubkey, crypto.BLSSignature,
+			DepositT, math.U64, WithdrawalCredentialsT,
+		]
+		GetPubkey() crypto.BLSPubkey
+		GetWithdrawalCredentials() WithdrawalCredentialsT
+		GetAmount() math.Gwei
+		GetSignature() crypto.BLSSignature
+	},
 	ExecutionPayloadT interfaces.ExecutionPayload[
 		ExecutionPayloadT, common.ExecutionAddress,
 		common.ExecutionHash, common.Bytes32,
@@ -53,15 +60,31 @@ func ProvideBeaconDepositContract[
 	WithdrawalCredentialsT ~[32]byte,
 ](
 	in BeaconDepositContractInput,
-) (
-	*deposit.WrappedBeaconDepositContract[DepositT, WithdrawalCredentialsT],
-	error,
-) {
-	// Build the deposit contract.
-	return deposit.NewWrappedBeaconDepositContract[
-		DepositT, WithdrawalCredentialsT,
-	](
-		in.ChainSpec.DepositContractAddress(),
-		in.EngineClient,
+) (deposit.Contract[DepositT], error) {
+	addresses := append(
+		[]common.ExecutionAddress{in.ChainSpec.DepositContractAddress()},
+		in.ChainSpec.AdditionalDepositContractAddresses()...,
 	)
+
+	// Build the deposit contracts.
+	contracts := make([]deposit.BlockContract[DepositT], 0, len(addresses))
+	for _, address := range addresses {
+		contract, err := deposit.NewWrappedBeaconDepositContract[
+			DepositT, WithdrawalCredentialsT,
+		](
+			address,
+			in.EngineClient,
+		)
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, contract)
+	}
+
+	if len(contracts) == 1 {
+		return contracts[0], nil
+	}
+	return deposit.NewMultiContract[DepositT, WithdrawalCredentialsT](
+		contracts...,
+	), nil
 }
@@ -24,7 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
-	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -34,20 +34,19 @@ import (
 // DepositServiceIn is the input for the deposit service.
 type DepositServiceIn struct {
 	depinject.In
-	BeaconDepositContract *deposit.WrappedBeaconDepositContract[
-		*Deposit, types.WithdrawalCredentials,
-	]
-	BlockFeed     *BlockFeed
-	ChainSpec     common.ChainSpec
-	DepositStore  *DepositStore
-	EngineClient  *EngineClient
-	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	BeaconDepositContract DepositContract
+	BlockFeed             *BlockFeed
+	ChainSpec             common.ChainSpec
+	Cfg                   *config.Config
+	DepositStore          *DepositStore
+	EngineClient          *EngineClient
+	Logger                log.Logger
+	TelemetrySink         *metrics.TelemetrySink
 }
 
 // ProvideDepositService provides the deposit service to the depinject
 // framework.
-func ProvideDepositService(in DepositServiceIn) *DepositService {
+func ProvideDepositService(in DepositServiceIn) (*DepositService, error) {
 	// Build the deposit service.
 	return deposit.NewService[
 		*BeaconBlockBody,
@@ -57,11 +56,14 @@ func ProvideDepositService(in DepositServiceIn) *DepositService {
 		*ExecutionPayload,
 		event.Subscription,
 	](
+		in.BlockFeed,
 		in.Logger.With("service", "deposit"),
-		math.U64(in.ChainSpec.Eth1FollowDistance()),
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
-		in.BlockFeed,
+		deposit.WithEth1FollowDistance[*Deposit](
+			math.U64(in.ChainSpec.Eth1FollowDistance()),
+		),
+		deposit.WithConfig[*Deposit](in.Cfg.Deposit),
 	)
 }
@@ -70,6 +70,7 @@ func ProvideEngineClient[
 // framework.
 type ExecutionEngineInput struct {
 	depinject.In
+	Config        *config.Config
 	EngineClient  *EngineClient
 	Logger        log.Logger
 	StatusFeed    *StatusFeed
@@ -93,5 +94,6 @@ func ProvideExecutionEngine[
 		in.Logger.With("service", "execution-engine"),
 		in.StatusFeed,
 		in.TelemetrySink,
+		in.Config.Engine.PayloadCacheSize,
 	)
 }
@@ -21,10 +21,13 @@
 package components
 
 import (
+	"runtime"
 	"strings"
 
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/afero"
@@ -35,19 +38,78 @@ import (
 type JWTSecretInput struct {
 	depinject.In
 	AppOpts servertypes.AppOptions
+	Logger  log.Logger
 }
 
+// ErrJWTSecretPermissions is returned when the JWT secret file is accessible
+// by users other than its owner.
+var ErrJWTSecretPermissions = errors.New(
+	"JWT secret file is accessible by group or others",
+)
+
 // ProvideJWTSecret is a function that provides the module to the application.
+// A JWT secret file accessible by group or others is reported as a warning,
+// or refused if strict permissions are required.
 func ProvideJWTSecret(in JWTSecretInput) (*jwt.Secret, error) {
-	return LoadJWTFromFile(cast.ToString(in.AppOpts.Get(flags.JWTSecretPath)))
+	filepath := cast.ToString(in.AppOpts.Get(flags.JWTSecretPath))
+	secret, err := LoadJWTFromFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = CheckJWTFilePermissions(filepath); err != nil {
+		if cast.ToBool(in.AppOpts.Get(flags.JWTStrictPermissions)) {
+			return nil, err
+		}
+		in.Logger.Warn("Insecure JWT secret file permissions", "error", err)
+	}
+	return secret, nil
+}
+
+// CheckJWTFilePermissions returns an error if the JWT secret file can be
+// read or written by its group or by others, much like SSH refuses private
+// keys that are not private to their owner.
+func CheckJWTFilePermissions(filepath string) error {
+	// File modes do not reflect access control lists on Windows.
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	info, err := afero.NewOsFs().Stat(filepath)
+	if err != nil {
+		return errors.Wrapf(
+			err, "failed to stat JWT secret file %s", filepath,
+		)
+	}
+	if mode := info.Mode().Perm(); mode&0o077 != 0 {
+		return errors.Wrapf(
+			ErrJWTSecretPermissions,
+			"%s has mode %s, expected 0600 or stricter", filepath, mode,
+		)
+	}
+	return nil
 }
 
-// LoadJWTFromFile reads the JWT secret from a file and returns it.
+// LoadJWTFromFile reads the JWT secret from a file and returns it. The file
+// must hold a 32-byte hex string, optionally 0x-prefixed, so that a missing
+// or malformed secret is reported at startup rather than as an
+// authentication failure on the first engine call.
 func LoadJWTFromFile(filepath string) (*jwt.Secret, error) {
 	data, err := afero.ReadFile(afero.NewOsFs(), filepath)
 	if err != nil {
 		// Return an error if the file cannot be read.
-		return nil, err
+		return nil, errors.Wrapf(
+			err, "failed to read JWT secret file %s", filepath,
+		)
+	}
+
+	secret, err := jwt.NewFromHex(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"invalid JWT secret in %s, expected a %d-byte hex string",
+			filepath, jwt.EthereumJWTLength,
+		)
 	}
-	return jwt.NewFromHex(strings.TrimSpace(string(data)))
+	return secret, nil
 }
@@ -40,6 +40,16 @@ func (TelemetrySink) IncrementCounter(key string, args ...string) {
 	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
 }
 
+// AddCounter adds the given value to a counter metric identified by the
+// provided keys.
+func (TelemetrySink) AddCounter(key string, value uint64, args ...string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{key},
+		float32(value),
+		argsToLabels(args...),
+	)
+}
+
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
 func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
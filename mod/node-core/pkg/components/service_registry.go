@@ -50,9 +50,11 @@ func ProvideServiceRegistry(
 		service.WithLogger(in.Logger),
 		service.WithService(in.ValidatorService),
 		service.WithService(in.ChainService),
+		// The engine client must be started before the deposit service,
+		// which verifies the deposit contract through it on start.
+		service.WithService(in.EngineClient),
 		service.WithService(in.DepositService),
 		service.WithService(in.ABCIService),
-		service.WithService(in.EngineClient),
 		service.WithService(version.NewReportingService(
 			in.Logger.With("service", "reporting"),
 			in.TelemetrySink,
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -35,6 +36,7 @@ type StateProcessorInput struct {
 	depinject.In
 	ChainSpec       common.ChainSpec
 	ExecutionEngine *ExecutionEngine
+	Logger          log.Logger
 	Signer          crypto.BLSSigner
 }
 
@@ -63,5 +65,6 @@ func ProvideStateProcessor(
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
+		in.Logger.With("service", "state-processor"),
 	)
 }
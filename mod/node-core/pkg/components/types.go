@@ -117,6 +117,9 @@ type (
 		*Genesis,
 	]
 
+	// DASampler is a type alias for the data availability sampler.
+	DASampler = blockchain.DASampler[*BeaconBlockBody]
+
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager[
 		*BeaconBlock,
@@ -127,6 +130,9 @@ type (
 	// Deposit is a type alias for the deposit.
 	Deposit = types.Deposit
 
+	// DepositContract is a type alias for the deposit contract.
+	DepositContract = deposit.Contract[*Deposit]
+
 	// DepositService is a type alias for the deposit service.
 	DepositService = deposit.Service[
 		*BeaconBlock,
@@ -20,6 +20,16 @@
 
 package chain
 
+const (
+	// defaultMaxExtraDataBytes is the maximum size of an execution payload's
+	// extra data for specs that do not set it, matching Ethereum.
+	defaultMaxExtraDataBytes = 32
+	// defaultMaxWithdrawalRequestsPerPayload is the maximum number of
+	// withdrawal requests per payload for specs that do not set it, as per
+	// EIP-7002.
+	defaultMaxWithdrawalRequestsPerPayload = 16
+)
+
 // Spec defines an interface for accessing chain-specific parameters.
 type Spec[
 	DomainTypeT ~[4]byte,
@@ -75,6 +85,9 @@ type Spec[
 	//
 	// DepositContractAddress returns the deposit contract address.
 	DepositContractAddress() ExecutionAddressT
+	// AdditionalDepositContractAddresses returns the addresses of further
+	// contracts emitting deposits.
+	AdditionalDepositContractAddresses() []ExecutionAddressT
 	// MaxDepositsPerBlock returns the maximum number of deposit operations per
 	// block.
 	MaxDepositsPerBlock() uint64
@@ -85,6 +98,18 @@ type Spec[
 	Eth1FollowDistance() uint64
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
+	// MinGenesisTime returns the earliest unix timestamp the chain may start
+	// at.
+	MinGenesisTime() uint64
+	// SecondsPerSlot returns the time between two slots. All slot to time
+	// conversions use it.
+	SecondsPerSlot() uint64
+	// MaxExtraDataBytes returns the maximum size of an execution payload's
+	// extra data, in bytes.
+	MaxExtraDataBytes() uint64
+	// ExtraDataPrefix returns the prefix the extra data of every execution
+	// payload must start with.
+	ExtraDataPrefix() []byte
 
 	// Fork-related values.
 	//
@@ -137,6 +162,12 @@ type Spec[
 	// BytesPerBlob returns the number of bytes per blob.
 	BytesPerBlob() uint64
 
+	// Electra Values
+	//
+	// MaxWithdrawalRequestsPerPayload returns the maximum number of execution
+	// layer withdrawal requests per payload.
+	MaxWithdrawalRequestsPerPayload() uint64
+
 	// Helpers for ChainSpecData
 	//
 	// ActiveForkVersionForSlot returns the active fork version for a given
@@ -150,6 +181,15 @@ type Spec[
 	// WithinDAPeriod checks if a given block slot is within the data
 	// availability period relative to the current slot.
 	WithinDAPeriod(block, current SlotT) bool
+	// RandaoMixIndexForSlot returns the index into the randao mixes vector
+	// of the mix that is current at the given slot.
+	RandaoMixIndexForSlot(slot SlotT) uint64
+	// TimeAtSlot returns the unix time in seconds at which the given slot
+	// starts, for a chain with the given genesis time.
+	TimeAtSlot(slot SlotT, genesisTime uint64) uint64
+	// SlotAtTime returns the slot that is current at the given unix time in
+	// seconds, for a chain with the given genesis time.
+	SlotAtTime(time, genesisTime uint64) SlotT
 
 	// CometBFT Consensus
 	GetCometBFTConfigForSlot(slot SlotT) CometBFTConfigT
@@ -301,6 +341,14 @@ func (c chainSpec[
 	return c.Data.DepositContractAddress
 }
 
+// AdditionalDepositContractAddresses returns the addresses of further
+// contracts emitting deposits, which is empty for specs that do not set it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) AdditionalDepositContractAddresses() []ExecutionAddressT {
+	return c.Data.AdditionalDepositContractAddresses
+}
+
 // MaxDepositsPerBlock returns the maximum number of deposits per block.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -330,6 +378,43 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// MinGenesisTime returns the earliest unix timestamp the chain may start at.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinGenesisTime() uint64 {
+	return c.Data.MinGenesisTime
+}
+
+// SecondsPerSlot returns the time between two slots, which defaults to the
+// target time between eth1 blocks for specs that do not set it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SecondsPerSlot() uint64 {
+	if c.Data.SecondsPerSlot == 0 {
+		return c.Data.TargetSecondsPerEth1Block
+	}
+	return c.Data.SecondsPerSlot
+}
+
+// MaxExtraDataBytes returns the maximum size of an execution payload's extra
+// data, in bytes, which defaults to 32 for specs that do not set it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxExtraDataBytes() uint64 {
+	if c.Data.MaxExtraDataBytes == 0 {
+		return defaultMaxExtraDataBytes
+	}
+	return c.Data.MaxExtraDataBytes
+}
+
+// ExtraDataPrefix returns the prefix the extra data of every execution
+// payload must start with, which is empty for specs that do not set it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ExtraDataPrefix() []byte {
+	return c.Data.ExtraDataPrefix
+}
+
 // ElectraForkEpoch returns the epoch of the Electra fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -432,6 +517,18 @@ func (c chainSpec[
 	return c.Data.BytesPerBlob
 }
 
+// MaxWithdrawalRequestsPerPayload returns the maximum number of execution
+// layer withdrawal requests per payload, which defaults to 16 for specs that
+// do not set it.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxWithdrawalRequestsPerPayload() uint64 {
+	if c.Data.MaxWithdrawalRequestsPerPayload == 0 {
+		return defaultMaxWithdrawalRequestsPerPayload
+	}
+	return c.Data.MaxWithdrawalRequestsPerPayload
+}
+
 // GetCometBFTConfigForSlot returns the CometBFT configuration for the given
 // slot.
 func (c chainSpec[
@@ -78,6 +78,10 @@ type SpecData[
 	//
 	// DepositContractAddress is the address of the deposit contract.
 	DepositContractAddress ExecutionAddressT `mapstructure:"deposit-contract-address"`
+	// AdditionalDepositContractAddresses are the addresses of further
+	// contracts emitting deposits, whose deposits are indexed together with
+	// those of the deposit contract.
+	AdditionalDepositContractAddresses []ExecutionAddressT `mapstructure:"additional-deposit-contract-addresses"`
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
@@ -88,6 +92,20 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// MinGenesisTime is the earliest unix timestamp the genesis execution
+	// payload, and thus the chain, may start at. If unset, any genesis time is
+	// accepted.
+	MinGenesisTime uint64 `mapstructure:"min-genesis-time"`
+	// SecondsPerSlot is the time between two slots. If unset, slots are
+	// TargetSecondsPerEth1Block apart.
+	SecondsPerSlot uint64 `mapstructure:"seconds-per-slot"`
+	// MaxExtraDataBytes is the maximum size of an execution payload's extra
+	// data, in bytes. If unset, it defaults to 32.
+	MaxExtraDataBytes uint64 `mapstructure:"max-extra-data-bytes"`
+	// ExtraDataPrefix is the prefix the extra data of every execution payload
+	// must start with, for permissioned networks whose proposers must stamp
+	// their payloads. If unset, any extra data is accepted.
+	ExtraDataPrefix []byte `mapstructure:"extra-data-prefix"`
 
 	// Fork-related values.
 	//
@@ -142,6 +160,13 @@ type SpecData[
 	// KZGCommitmentInclusionProofDepth is the depth of the KZG inclusion proof.
 	KZGCommitmentInclusionProofDepth uint64 `mapstructure:"kzg-commitment-inclusion-proof-depth"`
 
+	// Electra Values
+	//
+	// MaxWithdrawalRequestsPerPayload is the maximum number of execution
+	// layer withdrawal requests, as per EIP-7002, allowed in a single payload.
+	// If unset, it defaults to 16.
+	MaxWithdrawalRequestsPerPayload uint64 `mapstructure:"max-withdrawal-requests-per-payload"`
+
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
 }
@@ -70,3 +70,40 @@ func (c chainSpec[
 		current,
 	)
 }
+
+// RandaoMixIndexForSlot returns the index into the randao mixes vector of the
+// mix that is current at the given slot, i.e.
+//
+//	(slot / SLOTS_PER_EPOCH) % EPOCHS_PER_HISTORICAL_VECTOR
+//
+// There is no special case at epoch boundaries: the epoch transition, which
+// runs before the first block of a new epoch is processed, copies the mix of
+// the ending epoch into the slot for the new one. The first slot of an epoch
+// therefore already reads the rotated index, holding the previous epoch's
+// final mix until the block's randao reveal is mixed in.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) RandaoMixIndexForSlot(slot SlotT) uint64 {
+	return uint64(c.SlotToEpoch(slot)) % c.EpochsPerHistoricalVector()
+}
+
+// TimeAtSlot returns the unix time in seconds at which the given slot starts,
+// i.e. genesisTime + slot * SecondsPerSlot. The genesis slot
+// starts at the genesis time.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) TimeAtSlot(slot SlotT, genesisTime uint64) uint64 {
+	return genesisTime + uint64(slot)*c.SecondsPerSlot()
+}
+
+// SlotAtTime returns the slot that is current at the given unix time in
+// seconds. Times before the genesis time map to the genesis slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotAtTime(time, genesisTime uint64) SlotT {
+	secondsPerSlot := c.SecondsPerSlot()
+	if time < genesisTime || secondsPerSlot == 0 {
+		return 0
+	}
+	return SlotT((time - genesisTime) / secondsPerSlot)
+}
@@ -45,6 +45,8 @@ var spec = chain.NewChainSpec(
 		ElectraForkEpoch:                 10,
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
+		EpochsPerHistoricalVector:        4,
+		TargetSecondsPerEth1Block:        2,
 	},
 )
 
@@ -150,3 +152,176 @@ func TestWithinDAPeriod(t *testing.T) {
 		})
 	}
 }
+
+// TestRandaoMixIndexForSlot tests the RandaoMixIndexForSlot method.
+func TestRandaoMixIndexForSlot(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		slot     slot
+		expected uint64
+	}{
+		{name: "Genesis", slot: 0, expected: 0},
+		{name: "Last Slot Of Epoch 0", slot: 31, expected: 0},
+		{name: "First Slot Of Epoch 1", slot: 32, expected: 1},
+		{name: "Last Slot Of Epoch 1", slot: 63, expected: 1},
+		{name: "Last Slot Of Epoch 3", slot: 127, expected: 3},
+		// Epoch 4 wraps around the historical vector.
+		{name: "First Slot Of Epoch 4", slot: 128, expected: 0},
+		{name: "First Slot Of Epoch 5", slot: 160, expected: 1},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.RandaoMixIndexForSlot(tt.slot)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
+// TestTimeAtSlot tests the TimeAtSlot method.
+func TestTimeAtSlot(t *testing.T) {
+	const genesisTime = 1_700_000_000
+
+	// Define test cases
+	tests := []struct {
+		name     string
+		slot     slot
+		expected uint64
+	}{
+		{name: "Genesis", slot: 0, expected: genesisTime},
+		{name: "Slot 1", slot: 1, expected: genesisTime + 2},
+		{
+			name:     "Just Before Electra Fork",
+			slot:     319,
+			expected: genesisTime + 638,
+		},
+		{
+			name:     "At Electra Fork",
+			slot:     320,
+			expected: genesisTime + 640,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.TimeAtSlot(tt.slot, genesisTime)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
+// TestSlotAtTime tests the SlotAtTime method.
+func TestSlotAtTime(t *testing.T) {
+	const genesisTime = 1_700_000_000
+
+	// Define test cases
+	tests := []struct {
+		name     string
+		time     uint64
+		expected slot
+	}{
+		{name: "Before Genesis", time: genesisTime - 1, expected: 0},
+		{name: "Genesis", time: genesisTime, expected: 0},
+		{name: "Within Genesis Slot", time: genesisTime + 1, expected: 0},
+		{name: "Slot 1", time: genesisTime + 2, expected: 1},
+		{
+			name:     "Just Before Electra Fork",
+			time:     genesisTime + 639,
+			expected: 319,
+		},
+		{
+			name:     "At Electra Fork",
+			time:     genesisTime + 640,
+			expected: 320,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.SlotAtTime(tt.time, genesisTime)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+			// The start time of the slot maps back to the slot.
+			require.Equal(t, tt.expected, spec.SlotAtTime(
+				spec.TimeAtSlot(tt.expected, genesisTime), genesisTime,
+			))
+		})
+	}
+}
+
+// TestSecondsPerSlot tests that slot to time conversions use the
+// SecondsPerSlot override when it is set.
+func TestSecondsPerSlot(t *testing.T) {
+	const genesisTime = 1_700_000_000
+
+	oneSecondSpec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			ElectraForkEpoch:          10,
+			SlotsPerEpoch:             32,
+			TargetSecondsPerEth1Block: 2,
+			SecondsPerSlot:            1,
+		},
+	)
+	require.Equal(t, uint64(1), oneSecondSpec.SecondsPerSlot())
+	require.Equal(t, uint64(2), spec.SecondsPerSlot())
+
+	require.Equal(t,
+		uint64(genesisTime+320), oneSecondSpec.TimeAtSlot(320, genesisTime),
+	)
+	require.Equal(t,
+		slot(319), oneSecondSpec.SlotAtTime(genesisTime+319, genesisTime),
+	)
+	require.Equal(t,
+		slot(320), oneSecondSpec.SlotAtTime(genesisTime+320, genesisTime),
+	)
+}
+
+// TestMaxExtraDataBytes tests that the maximum extra data size defaults to
+// 32 bytes for specs that do not set it.
+func TestMaxExtraDataBytes(t *testing.T) {
+	require.Equal(t, uint64(32), spec.MaxExtraDataBytes())
+
+	customSpec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			MaxExtraDataBytes: 64,
+		},
+	)
+	require.Equal(t, uint64(64), customSpec.MaxExtraDataBytes())
+}
+
+// TestExtraDataPrefix tests that the extra data prefix is empty for specs
+// that do not set it.
+func TestExtraDataPrefix(t *testing.T) {
+	require.Empty(t, spec.ExtraDataPrefix())
+
+	customSpec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			ExtraDataPrefix: []byte("bera"),
+		},
+	)
+	require.Equal(t, []byte("bera"), customSpec.ExtraDataPrefix())
+}
+
+// TestMaxWithdrawalRequestsPerPayload tests that the maximum number of
+// withdrawal requests per payload defaults to 16 for specs that do not set it.
+func TestMaxWithdrawalRequestsPerPayload(t *testing.T) {
+	require.Equal(t, uint64(16), spec.MaxWithdrawalRequestsPerPayload())
+
+	customSpec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			MaxWithdrawalRequestsPerPayload: 8,
+		},
+	)
+	require.Equal(t, uint64(8), customSpec.MaxWithdrawalRequestsPerPayload())
+}
@@ -38,6 +38,10 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// SequentialValidation indicates whether to validate the execution
+	// payload on the calling goroutine instead of in parallel with the rest
+	// of the transition, so that logs are deterministic when debugging.
+	SequentialValidation bool
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +69,12 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetSequentialValidation returns whether to validate the execution payload
+// on the calling goroutine instead of in parallel.
+func (c *Context) GetSequentialValidation() bool {
+	return c.SequentialValidation
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
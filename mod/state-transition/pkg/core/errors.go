@@ -43,6 +43,15 @@ var (
 	// execution payload does not match the expected value.
 	ErrParentPayloadHashMismatch = errors.New("payload parent hash mismatch")
 
+	// ErrPayloadNumberMismatch is returned when the block number of an
+	// execution payload is not one more than that of its parent.
+	ErrPayloadNumberMismatch = errors.New("payload block number mismatch")
+
+	// ErrPayloadTimestampNotAfterParent is returned when the timestamp of an
+	// execution payload is not strictly after that of its parent.
+	ErrPayloadTimestampNotAfterParent = errors.New(
+		"payload timestamp not after parent")
+
 	// ErrRandaoMixMismatch is returned when the randao mix in an execution
 	// payload does not match the expected value.
 	ErrRandaoMixMismatch = errors.New("randao mix mismatch")
@@ -63,6 +72,23 @@ var (
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
 
+	// ErrExtraDataTooLong is returned when the execution payload's extra
+	// data exceeds the maximum allowed size.
+	ErrExtraDataTooLong = errors.New("extra data too long")
+
+	// ErrExtraDataPrefixMismatch is returned when the extra data of an
+	// execution payload does not start with the required prefix.
+	ErrExtraDataPrefixMismatch = errors.New("extra data prefix mismatch")
+
+	// ErrWithdrawalsLengthMismatch is returned when the number of
+	// withdrawals in an execution payload does not match the expected
+	// withdrawals sweep.
+	ErrWithdrawalsLengthMismatch = errors.New("withdrawals length mismatch")
+
+	// ErrWithdrawalMismatch is returned when a withdrawal in an execution
+	// payload does not match the expected withdrawals sweep.
+	ErrWithdrawalMismatch = errors.New("withdrawal mismatch")
+
 	// ErrSlashedProposer is returned when a block is processed in which
 	// the proposer is slashed.
 	ErrSlashedProposer = errors.New(
@@ -66,6 +66,7 @@ type ReadOnlyBeaconState[
 	GetBalance(math.ValidatorIndex) (math.Gwei, error)
 	GetSlot() (math.Slot, error)
 	GetGenesisValidatorsRoot() (common.Root, error)
+	GetGenesisTime() (uint64, error)
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
@@ -107,6 +108,7 @@ type WriteOnlyBeaconState[
 	WriteOnlyValidators[ValidatorT]
 
 	SetGenesisValidatorsRoot(root common.Root) error
+	SetGenesisTime(genesisTime uint64) error
 	SetFork(ForkT) error
 	SetSlot(math.Slot) error
 	UpdateBlockRootAtIndex(uint64, common.Root) error
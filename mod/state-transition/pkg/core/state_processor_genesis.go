@@ -125,6 +125,13 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// The genesis time is the timestamp of the genesis execution payload.
+	if err = st.SetGenesisTime(
+		executionPayloadHeader.GetTimestamp().Unwrap(),
+	); err != nil {
+		return nil, err
+	}
+
 	if err = st.SetLatestExecutionPayloadHeader(
 		executionPayloadHeader,
 	); err != nil {
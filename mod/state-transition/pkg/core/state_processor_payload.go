@@ -21,6 +21,7 @@
 package core
 
 import (
+	"bytes"
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
@@ -47,6 +48,23 @@ func (sp *StateProcessor[
 		g, gCtx = errgroup.WithContext(context.Background())
 	)
 
+	// When debugging, validate the payload and then build its header on this
+	// goroutine, so that logs and stack traces are deterministic.
+	if ctx.GetSequentialValidation() {
+		if !ctx.GetSkipPayloadVerification() {
+			if err := sp.validateExecutionPayload(
+				context.Background(), st, blk, ctx.GetOptimisticEngine(),
+			); err != nil {
+				return err
+			}
+		}
+		var err error
+		if header, err = payload.ToHeader(); err != nil {
+			return err
+		}
+		return st.SetLatestExecutionPayloadHeader(header)
+	}
+
 	// Skip payload verification if the context is configured as such.
 	if !ctx.GetSkipPayloadVerification() {
 		g.Go(func() error {
@@ -108,11 +126,66 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// The payload must directly follow its parent.
+	if expected := lph.GetNumber() + 1; payload.GetNumber() != expected {
+		return errors.Wrapf(
+			ErrPayloadNumberMismatch,
+			"expected: %d, got: %d",
+			expected, payload.GetNumber(),
+		)
+	}
+
+	// The payload must be timestamped after its parent. This only depends
+	// on the state, so that every node reaches the same result; how far it
+	// may be ahead of the wall clock is checked when verifying proposals.
+	if payload.GetTimestamp() <= lph.GetTimestamp() {
+		return errors.Wrapf(
+			ErrPayloadTimestampNotAfterParent,
+			"parent: %d, got: %d",
+			lph.GetTimestamp(), payload.GetTimestamp(),
+		)
+	}
+
+	// Verify the number of blobs before notifying the execution client, so
+	// that a block carrying more blobs than the network allows is rejected
+	// without the engine ever seeing its payload.
+	blobKzgCommitments := body.GetBlobKzgCommitments()
+	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
+		return errors.Wrapf(
+			ErrExceedsBlockBlobLimit,
+			"limit: %d, got: %d",
+			sp.cs.MaxBlobsPerBlock(), len(blobKzgCommitments),
+		)
+	}
+
+	// Verify the size of the extra data before notifying the execution
+	// client, so that oversized payloads never reach it.
+	if extraData := payload.GetExtraData(); uint64(
+		len(extraData),
+	) > sp.cs.MaxExtraDataBytes() {
+		return errors.Wrapf(
+			ErrExtraDataTooLong,
+			"limit: %d, got: %d",
+			sp.cs.MaxExtraDataBytes(), len(extraData),
+		)
+	}
+
+	// Permissioned networks may require proposers to stamp their payloads.
+	if prefix := sp.cs.ExtraDataPrefix(); !bytes.HasPrefix(
+		payload.GetExtraData(), prefix,
+	) {
+		return errors.Wrapf(
+			ErrExtraDataPrefixMismatch,
+			"expected prefix: %x, got extra data: %x",
+			prefix, payload.GetExtraData(),
+		)
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
-			body.GetBlobKzgCommitments().ToVersionedHashes(),
+			blobKzgCommitments.ToVersionedHashes(),
 			&parentBeaconBlockRoot,
 			optimisticEngine,
 		),
@@ -129,7 +202,8 @@ func (sp *StateProcessor[
 	// When we are verifying a payload we expect that it was produced by
 	// the proposer for the slot that it is for.
 	expectedMix, err := st.GetRandaoMixAtIndex(
-		uint64(sp.cs.SlotToEpoch(slot)) % sp.cs.EpochsPerHistoricalVector())
+		sp.cs.RandaoMixIndexForSlot(slot),
+	)
 	if err != nil {
 		return err
 	}
@@ -143,25 +217,15 @@ func (sp *StateProcessor[
 		)
 	}
 
-	// TODO: Verify timestamp data once Clock is done.
-	// if expectedTime, err := spec.TimeAtSlot(slot, genesisTime); err !=
-	// nil { 	return errors.Newf("slot or genesis time in state is corrupt,
-	// cannot
-	// compute time: %v", err)
-	// } else if payload.Timestamp != expectedTime {
-	// 	return errors.Newf("state at slot %d, genesis time %d, expected
-	// execution
-	// payload time %d, but got %d",
-	// 		slot, genesisTime, expectedTime, payload.Timestamp)
-	// }
-
-	// Verify the number of blobs.
-	blobKzgCommitments := body.GetBlobKzgCommitments()
-	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
-		return errors.Wrapf(
-			ErrExceedsBlockBlobLimit,
-			"expected: %d, got: %d",
-			sp.cs.MaxBlobsPerBlock(), len(blobKzgCommitments),
+	// The randao mix changes with every block, so a payload reusing the
+	// prevRandao of its parent usually indicates a mixing bug. Such payloads
+	// are still valid, so that every node agrees on them, and are only
+	// reported. The genesis payload has no parent to compare against.
+	if slot > 0 && payload.GetPrevRandao() == lph.GetPrevRandao() {
+		sp.logger.Warn(
+			"Execution payload reuses the prev randao of its parent",
+			"slot", slot,
+			"prev_randao", payload.GetPrevRandao(),
 		)
 	}
 
@@ -82,6 +82,46 @@ func (sp *StateProcessor[
 	return nil
 }
 
+// ApplyPendingDeposits applies up to maxDeposits deposits from the deposit
+// store to the state, starting at the eth1 deposit index of the state. This
+// rebuilds the validator set from deposits that were imported into the store
+// without being included in blocks, e.g. from a snapshot. It returns the
+// number of deposits applied.
+func (sp *StateProcessor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BeaconStateT, BlobSidecarsT, ContextT,
+	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
+]) ApplyPendingDeposits(
+	st BeaconStateT,
+	ds DepositStore[DepositT],
+	maxDeposits uint64,
+) (uint64, error) {
+	index, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return 0, err
+	}
+
+	deposits, err := ds.GetDepositsByIndex(index, maxDeposits)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, dep := range deposits {
+		// The deposits must be applied in order, without gaps.
+		if expected := index + uint64(i); dep.GetIndex() != expected {
+			return uint64(i), errors.Newf(
+				"unexpected deposit index, expected: %d, got: %d",
+				expected, dep.GetIndex(),
+			)
+		}
+		if err = sp.processDeposit(st, dep); err != nil {
+			return uint64(i), err
+		}
+	}
+	return uint64(len(deposits)), nil
+}
+
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
@@ -261,19 +301,22 @@ func (sp *StateProcessor[
 
 	// Ensure the withdrawals have the same length
 	if numWithdrawals != len(payloadWithdrawals) {
-		return errors.Newf(
-			"withdrawals do not match expected length %d, got %d",
+		return errors.Wrapf(
+			ErrWithdrawalsLengthMismatch,
+			"expected: %d, got: %d",
 			len(expectedWithdrawals), len(payloadWithdrawals),
 		)
 	}
 
 	// Compare and process each withdrawal.
 	for i, wd := range expectedWithdrawals {
-		// Ensure the withdrawals match the local state.
+		// Ensure the withdrawals match the expected sweep of the local
+		// state, and not just in number.
 		if !wd.Equals(payloadWithdrawals[i]) {
-			return errors.Newf(
-				"withdrawals do not match expected %s, got %s",
-				spew.Sdump(wd), spew.Sdump(payloadWithdrawals[i]),
+			return errors.Wrapf(
+				ErrWithdrawalMismatch,
+				"at index %d, expected %s, got %s",
+				i, spew.Sdump(wd), spew.Sdump(payloadWithdrawals[i]),
 			)
 		}
 
@@ -121,6 +121,9 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetSequentialValidation returns whether to validate the execution
+	// payload on the calling goroutine instead of in parallel.
+	GetSequentialValidation() bool
 
 	// Unwrap returns the underlying golang standard library context.
 	Unwrap() context.Context
@@ -152,6 +155,14 @@ type Deposit[
 	) error
 }
 
+// DepositStore is the interface for the store of deposits read from the
+// deposit contract.
+type DepositStore[DepositT any] interface {
+	// GetDepositsByIndex returns up to numView deposits starting from the
+	// given index.
+	GetDepositsByIndex(startIndex, numView uint64) ([]DepositT, error)
+}
+
 type ExecutionPayload[
 	ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT any,
 ] interface {
@@ -181,6 +192,13 @@ type ExecutionPayload[
 	IsNil() bool
 }
 
+// Logger is the logger used by the state processor to report suspicious
+// but valid input.
+type Logger interface {
+	// Warn logs a warning message with associated key-value pairs.
+	Warn(msg string, keyVals ...any)
+}
+
 type ExecutionPayloadHeader interface {
 	Version() uint32
 	GetParentHash() common.ExecutionHash
@@ -50,6 +50,8 @@ type KVStore[
 	// Versioning
 	// genesisValidatorsRoot is the root of the genesis validators.
 	genesisValidatorsRoot sdkcollections.Item[[]byte]
+	// genesisTime is the timestamp of the genesis execution payload.
+	genesisTime sdkcollections.Item[uint64]
 	// slot is the current slot.
 	slot sdkcollections.Item[uint64]
 	// fork is the current fork
@@ -129,6 +131,12 @@ func New[
 			keys.GenesisValidatorsRootPrefixHumanReadable,
 			sdkcollections.BytesValue,
 		),
+		genesisTime: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.GenesisTimePrefix}),
+			keys.GenesisTimePrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
 		slot: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.SlotPrefix}),
@@ -49,6 +49,23 @@ func (kv *KVStore[
 	return common.Root(bz), nil
 }
 
+// SetGenesisTime sets the genesis time, i.e. the timestamp of the genesis
+// execution payload, in the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) SetGenesisTime(genesisTime uint64) error {
+	return kv.genesisTime.Set(kv.ctx, genesisTime)
+}
+
+// GetGenesisTime retrieves the genesis time from the beacon state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT,
+]) GetGenesisTime() (uint64, error) {
+	return kv.genesisTime.Get(kv.ctx)
+}
+
 // GetSlot returns the current slot.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
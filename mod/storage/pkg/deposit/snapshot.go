@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+)
+
+// A snapshot is the number of deposits as a little-endian uint64, followed
+// by each deposit in index order, SSZ encoded and prefixed with its length as
+// a little-endian uint32.
+const (
+	// snapshotCountSize is the size of the deposit count header.
+	snapshotCountSize = 8
+	// snapshotLengthSize is the size of the length prefix of a deposit.
+	snapshotLengthSize = 4
+	// maxSnapshotDepositSize bounds the length of a single encoded deposit,
+	// so that a corrupt length prefix cannot cause a huge allocation.
+	maxSnapshotDepositSize = 1 << 10
+)
+
+var (
+	// ErrSnapshotCountMismatch is returned when a snapshot does not hold the
+	// expected number of deposits.
+	ErrSnapshotCountMismatch = errors.New("snapshot deposit count mismatch")
+	// ErrSnapshotNonContiguous is returned when the deposit indices of a
+	// snapshot are not ascending and contiguous.
+	ErrSnapshotNonContiguous = errors.New(
+		"snapshot deposit indices are not contiguous",
+	)
+	// ErrSnapshotRootMismatch is returned when the deposits of a snapshot do
+	// not hash to the expected root.
+	ErrSnapshotRootMismatch = errors.New("snapshot root mismatch")
+	// ErrAtomicWritesUnsupported is returned when a snapshot is imported
+	// into a store that cannot apply a batch of writes atomically.
+	ErrAtomicWritesUnsupported = errors.New(
+		"store does not support atomic writes",
+	)
+)
+
+// SnapshotRoot returns the root of a snapshot holding the given deposits,
+// i.e. the root of the Merkle tree of their hash tree roots, with the number
+// of deposits mixed in.
+func SnapshotRoot[DepositT Deposit](deposits []DepositT) (common.Root, error) {
+	if len(deposits) == 0 {
+		return merkle.MixinLength(common.Root{}, 0), nil
+	}
+
+	leaves := make([]common.Root, 0, len(deposits))
+	for _, deposit := range deposits {
+		root, err := deposit.HashTreeRoot()
+		if err != nil {
+			return common.Root{}, err
+		}
+		leaves = append(leaves, root)
+	}
+
+	tree, err := merkle.NewTreeFromLeaves[common.Root, common.Root](leaves)
+	if err != nil {
+		return common.Root{}, err
+	}
+	root, err := tree.HashTreeRoot()
+	return common.Root(root), err
+}
+
+// ExportSnapshot writes every deposit in the store to w, and returns the
+// root of the snapshot to be checked on import.
+func (kv *KVStore[DepositT]) ExportSnapshot(w io.Writer) (common.Root, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(context.TODO(), nil)
+	if err != nil {
+		return common.Root{}, err
+	}
+	deposits, err := iter.Values()
+	if err != nil {
+		return common.Root{}, err
+	}
+	root, err := SnapshotRoot(deposits)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	header := make([]byte, snapshotCountSize)
+	binary.LittleEndian.PutUint64(header, uint64(len(deposits)))
+	if _, err = w.Write(header); err != nil {
+		return common.Root{}, err
+	}
+
+	prefix := make([]byte, snapshotLengthSize)
+	for _, deposit := range deposits {
+		var bz []byte
+		if bz, err = deposit.MarshalSSZ(); err != nil {
+			return common.Root{}, err
+		}
+		//#nosec:G701 // a deposit is far smaller than uint32 max.
+		binary.LittleEndian.PutUint32(prefix, uint32(len(bz)))
+		if _, err = w.Write(prefix); err != nil {
+			return common.Root{}, err
+		}
+		if _, err = w.Write(bz); err != nil {
+			return common.Root{}, err
+		}
+	}
+	return root, nil
+}
+
+// ImportSnapshot replaces the contents of the store with the deposits read
+// from r. The snapshot must hold exactly expectedCount deposits with
+// ascending, contiguous indices and have the expected root, otherwise the
+// store is left untouched. The deposits are staged in a batch that replaces
+// the contents of the store in a single write.
+func (kv *KVStore[DepositT]) ImportSnapshot(
+	r io.Reader,
+	expectedCount uint64,
+	expectedRoot common.Root,
+) error {
+	deposits, err := readSnapshot[DepositT](r)
+	if err != nil {
+		return err
+	}
+	if uint64(len(deposits)) != expectedCount {
+		return errors.Wrapf(
+			ErrSnapshotCountMismatch,
+			"expected: %d, got: %d", expectedCount, len(deposits),
+		)
+	}
+	for i := 1; i < len(deposits); i++ {
+		if deposits[i].GetIndex() != deposits[i-1].GetIndex()+1 {
+			return errors.Wrapf(
+				ErrSnapshotNonContiguous,
+				"index %d follows index %d",
+				deposits[i].GetIndex(), deposits[i-1].GetIndex(),
+			)
+		}
+	}
+
+	root, err := SnapshotRoot(deposits)
+	if err != nil {
+		return err
+	}
+	if root != expectedRoot {
+		return errors.Wrapf(
+			ErrSnapshotRootMismatch,
+			"expected: %s, got: %s", expectedRoot, root,
+		)
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.replaceDeposits(deposits)
+}
+
+// replaceDeposits replaces the deposits in the store with the given ones,
+// which must have contiguous indices, in a single batch write. Deposits
+// below the first given one are considered processed.
+func (kv *KVStore[DepositT]) replaceDeposits(deposits []DepositT) error {
+	kvs, ok := kv.kvsp.OpenKVStore(context.TODO()).(store.KVStoreWithBatch)
+	if !ok {
+		return ErrAtomicWritesUnsupported
+	}
+	batch := kvs.NewBatch()
+	defer batch.Close()
+
+	// Stage the removal of the deposits not replaced by the snapshot.
+	iter, err := kv.store.Iterate(context.TODO(), nil)
+	if err != nil {
+		return err
+	}
+	indices, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, index := range indices {
+		if len(deposits) > 0 &&
+			index >= deposits[0].GetIndex() &&
+			index <= deposits[len(deposits)-1].GetIndex() {
+			continue
+		}
+		if err = kv.stageDeposit(batch, index, nil); err != nil {
+			return err
+		}
+	}
+
+	// Stage the deposits of the snapshot.
+	for _, deposit := range deposits {
+		var bz []byte
+		if bz, err = kv.store.ValueCodec().Encode(deposit); err != nil {
+			return err
+		}
+		if err = kv.stageDeposit(batch, deposit.GetIndex(), bz); err != nil {
+			return err
+		}
+	}
+
+	// Stage the pruned index, so that the deposits below the snapshot are
+	// still reported as contained.
+	if len(deposits) > 0 {
+		var pruned uint64
+		if pruned, err = kv.getPrunedIndex(); err != nil {
+			return err
+		}
+		if first := deposits[0].GetIndex(); first > pruned {
+			var bz []byte
+			if bz, err = sdkcollections.Uint64Value.Encode(first); err != nil {
+				return err
+			}
+			if err = batch.Set(prunedIndexPrefix, bz); err != nil {
+				return err
+			}
+		}
+	}
+
+	return batch.Write()
+}
+
+// stageDeposit stages setting the encoded deposit with the given index in
+// the batch, or deleting it if bz is nil.
+func (kv *KVStore[DepositT]) stageDeposit(
+	batch store.Batch,
+	index uint64,
+	bz []byte,
+) error {
+	key, err := sdkcollections.EncodeKeyWithPrefix(
+		kv.store.GetPrefix(), kv.store.KeyCodec(), index,
+	)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return batch.Delete(key)
+	}
+	return batch.Set(key, bz)
+}
+
+// readSnapshot decodes the deposits of a snapshot read from r.
+func readSnapshot[DepositT Deposit](r io.Reader) ([]DepositT, error) {
+	header := make([]byte, snapshotCountSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot header")
+	}
+
+	var (
+		count    = binary.LittleEndian.Uint64(header)
+		prefix   = make([]byte, snapshotLengthSize)
+		codec    = encoding.SSZValueCodec[DepositT]{}
+		deposits []DepositT
+	)
+	for i := range count {
+		if _, err := io.ReadFull(r, prefix); err != nil {
+			return nil, errors.Wrapf(err, "failed to read deposit %d", i)
+		}
+		length := binary.LittleEndian.Uint32(prefix)
+		if length > maxSnapshotDepositSize {
+			return nil, errors.Newf(
+				"deposit %d too large: %d bytes", i, length,
+			)
+		}
+		bz := make([]byte, length)
+		if _, err := io.ReadFull(r, bz); err != nil {
+			return nil, errors.Wrapf(err, "failed to read deposit %d", i)
+		}
+		deposit, err := codec.Decode(bz)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode deposit %d", i)
+		}
+		deposits = append(deposits, deposit)
+	}
+	return deposits, nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// requireIndices requires the store to hold exactly the deposits with the
+// given indices, starting at the first one.
+func requireIndices(
+	t *testing.T,
+	kv *deposit.KVStore[*testDeposit],
+	indices ...uint64,
+) {
+	t.Helper()
+	count, err := kv.PendingCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(indices)), count)
+
+	deposits, err := kv.GetDepositsByIndex(indices[0], uint64(len(indices)))
+	require.NoError(t, err)
+	got := make([]uint64, 0, len(deposits))
+	for _, d := range deposits {
+		got = append(got, d.GetIndex())
+	}
+	require.Equal(t, indices, got)
+}
+
+// TestSnapshotRoundTrip tests that an exported snapshot replaces the
+// contents of another store on import.
+func TestSnapshotRoundTrip(t *testing.T) {
+	src := newTestStore(t, 3, 4, 5, 6, 7)
+	var buf bytes.Buffer
+	root, err := src.ExportSnapshot(&buf)
+	require.NoError(t, err)
+
+	dst := newTestStore(t, 0, 1, 2, 10)
+	require.NoError(t, dst.ImportSnapshot(&buf, 5, root))
+	requireIndices(t, dst, 3, 4, 5, 6, 7)
+
+	// Deposits below the snapshot have been processed, those above it were
+	// discarded.
+	found, err := dst.ContainsDepositIndex(0)
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = dst.ContainsDepositIndex(10)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+// TestImportSnapshotRejects tests that invalid snapshots are rejected and
+// leave the store untouched.
+func TestImportSnapshotRejects(t *testing.T) {
+	var contiguous, gapped bytes.Buffer
+	root, err := newTestStore(t, 5, 6, 7).ExportSnapshot(&contiguous)
+	require.NoError(t, err)
+	gappedRoot, err := newTestStore(t, 5, 7, 8).ExportSnapshot(&gapped)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		snapshot []byte
+		count    uint64
+		root     common.Root
+		expected error
+	}{
+		{
+			name:     "Count mismatch",
+			snapshot: contiguous.Bytes(),
+			count:    4,
+			root:     root,
+			expected: deposit.ErrSnapshotCountMismatch,
+		},
+		{
+			name:     "Root mismatch",
+			snapshot: contiguous.Bytes(),
+			count:    3,
+			root:     common.Root{1},
+			expected: deposit.ErrSnapshotRootMismatch,
+		},
+		{
+			name:     "Non contiguous",
+			snapshot: gapped.Bytes(),
+			count:    3,
+			root:     gappedRoot,
+			expected: deposit.ErrSnapshotNonContiguous,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newTestStore(t, 0, 1)
+			err := kv.ImportSnapshot(
+				bytes.NewReader(tt.snapshot), tt.count, tt.root,
+			)
+			require.ErrorIs(t, err, tt.expected)
+			requireIndices(t, kv, 0, 1)
+		})
+	}
+}
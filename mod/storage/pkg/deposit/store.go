@@ -34,7 +34,16 @@ import (
 // Deposit is a struct that holds the deposit information.
 var _ pruner.Prunable = (*KVStore[Deposit])(nil)
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+	// KeyPrunedIndexPrefix is the key of the pruned deposit index.
+	KeyPrunedIndexPrefix = "pruned_deposit_index"
+)
+
+// prunedIndexPrefix is the store prefix of the pruned deposit index.
+//
+//nolint:gochecknoglobals // a slice cannot be a constant.
+var prunedIndexPrefix = []byte{uint8(1)}
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -48,14 +57,22 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit] struct {
+	// kvsp opens the underlying KV store, which is written to directly
+	// when a batch of changes must be applied atomically.
+	kvsp  store.KVStoreService
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// prunedIndex is one past the highest deposit index pruned from the
+	// store. Deposits are only pruned once processed, so every deposit
+	// below it has been processed.
+	prunedIndex sdkcollections.Item[uint64]
+	mu          sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
 func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
 	return &KVStore[DepositT]{
+		kvsp: kvsp,
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{uint8(0)}),
@@ -63,6 +80,12 @@ func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		prunedIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(prunedIndexPrefix),
+			KeyPrunedIndexPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -89,6 +112,71 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// PendingCount returns the number of deposits currently held in the store,
+// i.e. those that have been enqueued but not yet pruned. Deposit indices are
+// contiguous, so it is computed from the lowest and highest stored index
+// without scanning the store.
+func (kv *KVStore[DepositT]) PendingCount() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	lowest, found, err := kv.firstIndex(nil)
+	if err != nil || !found {
+		return 0, err
+	}
+	highest, _, err := kv.firstIndex(
+		new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return highest - lowest + 1, nil
+}
+
+// firstIndex returns the first deposit index in the store in the order of
+// the given range, and whether there is any.
+func (kv *KVStore[DepositT]) firstIndex(
+	ranger sdkcollections.Ranger[uint64],
+) (uint64, bool, error) {
+	iter, err := kv.store.Iterate(context.TODO(), ranger)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	index, err := iter.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return index, true, nil
+}
+
+// ContainsDepositIndex reports whether the deposit with the given index has
+// been stored, including deposits that have since been processed and pruned.
+func (kv *KVStore[DepositT]) ContainsDepositIndex(idx uint64) (bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	pruned, err := kv.getPrunedIndex()
+	if err != nil {
+		return false, err
+	}
+	if idx < pruned {
+		return true, nil
+	}
+	return kv.store.Has(context.TODO(), idx)
+}
+
+// getPrunedIndex returns one past the highest deposit index pruned from the
+// store, or zero if none has been pruned yet.
+func (kv *KVStore[DepositT]) getPrunedIndex() (uint64, error) {
+	pruned, err := kv.prunedIndex.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return pruned, err
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
@@ -113,15 +201,38 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex(), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
-func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
+// Prune removes `end` deposits starting at `start` from the store and returns
+// the number of deposits removed.
+func (kv *KVStore[DepositT]) Prune(start, end uint64) (uint64, error) {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	var numPruned uint64
 	for i := range end {
+		has, err := kv.store.Has(context.TODO(), start+i)
+		if err != nil {
+			return numPruned, err
+		}
+		if !has {
+			continue
+		}
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
-			return err
+		if err = kv.store.Remove(context.TODO(), start+i); err != nil {
+			return numPruned, err
 		}
+		numPruned++
 	}
-	return nil
+	if end == 0 {
+		return 0, nil
+	}
+
+	// Remember how far the store has been pruned, so that pruned deposits
+	// are still reported as contained.
+	pruned, err := kv.getPrunedIndex()
+	if err != nil {
+		return numPruned, err
+	}
+	if start+end > pruned {
+		return numPruned, kv.prunedIndex.Set(context.TODO(), start+end)
+	}
+	return numPruned, nil
 }
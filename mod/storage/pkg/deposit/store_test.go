@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package deposit_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a deposit that only holds its index.
+type testDeposit struct {
+	index uint64
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return d.index
+}
+
+func (d *testDeposit) SizeSSZ() int {
+	//nolint:mnd // a uint64.
+	return 8
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(nil)
+}
+
+func (d *testDeposit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, d.index), nil
+}
+
+func (d *testDeposit) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != d.SizeSSZ() {
+		return errors.Newf("invalid deposit size: %d", len(bz))
+	}
+	d.index = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (d *testDeposit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], d.index)
+	return root, nil
+}
+
+// memStore is a store.KVStoreWithBatch backed by an in-memory database.
+type memStore struct {
+	dbm.DB
+}
+
+func (s memStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.DB.Iterator(start, end)
+}
+
+func (s memStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.DB.ReverseIterator(start, end)
+}
+
+func (s memStore) NewBatch() store.Batch {
+	return s.DB.NewBatch()
+}
+
+func (s memStore) NewBatchWithSize(size int) store.Batch {
+	return s.DB.NewBatchWithSize(size)
+}
+
+// memStoreService opens the same in-memory store for every context.
+type memStoreService struct {
+	db dbm.DB
+}
+
+func (s memStoreService) OpenKVStore(context.Context) store.KVStore {
+	return memStore{DB: s.db}
+}
+
+// newTestStore creates a deposit store holding deposits with the given
+// indices.
+func newTestStore(
+	t *testing.T,
+	indices ...uint64,
+) *deposit.KVStore[*testDeposit] {
+	t.Helper()
+	kv := deposit.NewStore[*testDeposit](
+		memStoreService{db: dbm.NewMemDB()},
+	)
+	deposits := make([]*testDeposit, 0, len(indices))
+	for _, index := range indices {
+		deposits = append(deposits, &testDeposit{index: index})
+	}
+	require.NoError(t, kv.EnqueueDeposits(deposits))
+	return kv
+}
+
+// TestContainsDepositIndex tests that deposits are reported as contained
+// once stored, including after they have been pruned.
+func TestContainsDepositIndex(t *testing.T) {
+	kv := newTestStore(t, 0, 1, 2, 3, 4)
+	numPruned, err := kv.Prune(0, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), numPruned)
+
+	tests := []struct {
+		name     string
+		index    uint64
+		expected bool
+	}{
+		{name: "Pruned", index: 0, expected: true},
+		{name: "Last pruned", index: 2, expected: true},
+		{name: "Stored", index: 3, expected: true},
+		{name: "Never stored", index: 5, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := kv.ContainsDepositIndex(tt.index)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, found)
+		})
+	}
+}
+
+// TestPendingCount tests that the pending count follows the deposits
+// enqueued and pruned.
+func TestPendingCount(t *testing.T) {
+	kv := newTestStore(t)
+	count, err := kv.PendingCount()
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	kv = newTestStore(t, 0, 1, 2, 3, 4)
+	count, err = kv.PendingCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), count)
+
+	_, err = kv.Prune(0, 2)
+	require.NoError(t, err)
+	count, err = kv.PendingCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	_, err = kv.Prune(2, 3)
+	require.NoError(t, err)
+	count, err = kv.PendingCount()
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
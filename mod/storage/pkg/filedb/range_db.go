@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -89,31 +90,52 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
 func (db *RangeDB) DeleteRange(from, to uint64) error {
+	_, err := db.deleteRange(from, to)
+	return err
+}
+
+// deleteRange removes all values associated with the indexes in [from, to)
+// from the filesystem and returns the number of indexes that held values.
+func (db *RangeDB) deleteRange(from, to uint64) (uint64, error) {
 	f, ok := db.DB.(*DB)
 	if !ok {
-		return errors.New("rangedb: delete range not supported for this db")
+		return 0, errors.New(
+			"rangedb: delete range not supported for this db",
+		)
 	}
+	var numDeleted uint64
 	for ; from < to; from++ {
-		if err := f.fs.RemoveAll(fmt.Sprintf("%d/", from)); err != nil {
-			return err
+		dir := fmt.Sprintf("%d/", from)
+		exists, err := afero.DirExists(f.fs, dir)
+		if err != nil {
+			return numDeleted, err
+		}
+		if !exists {
+			continue
 		}
+		if err = f.fs.RemoveAll(dir); err != nil {
+			return numDeleted, err
+		}
+		numDeleted++
 	}
-	return nil
+	return numDeleted, nil
 }
 
-// Prune removes all values in the given range [start, end) from the db.
-func (db *RangeDB) Prune(start, end uint64) error {
+// Prune removes all values in the given range [start, end) from the db and
+// returns the number of indexes that held values.
+func (db *RangeDB) Prune(start, end uint64) (uint64, error) {
 	start = max(start, db.firstNonNilIndex)
-	if err := db.DeleteRange(start, end); err != nil {
+	numPruned, err := db.deleteRange(start, end)
+	if err != nil {
 		// Resets last pruned index in case Delete somehow populates indices on
 		// err. This will cause the next prune operation is O(n), but next
 		// successful prune will set it to the correct value, so runtime is
 		// ammortized
 		db.firstNonNilIndex = 0
-		return err
+		return numPruned, err
 	}
 	db.firstNonNilIndex = end
-	return nil
+	return numPruned, nil
 }
 
 // prefix prefixes the given key with the index and a slash.
@@ -224,21 +224,23 @@ func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 
 func TestRangeDB_Prune(t *testing.T) {
 	tests := []struct {
-		name          string
-		setupFunc     func(rdb *file.RangeDB) error
-		start         uint64
-		end           uint64
-		expectedError bool
-		testFunc      func(t *testing.T, rdb *file.RangeDB)
+		name           string
+		setupFunc      func(rdb *file.RangeDB) error
+		start          uint64
+		end            uint64
+		expectedError  bool
+		expectedPruned uint64
+		testFunc       func(t *testing.T, rdb *file.RangeDB)
 	}{
 		{
 			name: "PruneWithDeleteRange",
 			setupFunc: func(rdb *file.RangeDB) error {
 				return populateTestDB(rdb, 0, 50)
 			},
-			start:         2,
-			end:           7,
-			expectedError: false,
+			start:          2,
+			end:            7,
+			expectedError:  false,
+			expectedPruned: 5,
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
 				requireNotExist(t, rdb, 2, 6)
@@ -246,11 +248,29 @@ func TestRangeDB_Prune(t *testing.T) {
 				requireExist(t, rdb, 0, 1)
 			},
 		},
+		{
+			name: "PruneSparse",
+			setupFunc: func(rdb *file.RangeDB) error {
+				if err := populateTestDB(rdb, 0, 3); err != nil {
+					return err
+				}
+				return rdb.Set(10, []byte("key"), []byte("value"))
+			},
+			start:          2,
+			end:            12,
+			expectedError:  false,
+			expectedPruned: 3,
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				requireNotExist(t, rdb, 2, 11)
+				requireExist(t, rdb, 0, 1)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-2"))
+			rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
 
 			if tt.setupFunc != nil {
 				if err := tt.setupFunc(rdb); (err != nil) != tt.expectedError {
@@ -261,7 +281,7 @@ func TestRangeDB_Prune(t *testing.T) {
 					)
 				}
 			}
-			err := rdb.Prune(tt.start, tt.end)
+			numPruned, err := rdb.Prune(tt.start, tt.end)
 			if (err != nil) != tt.expectedError {
 				t.Fatalf(
 					"Prune() error = %v, expectedError %v",
@@ -269,6 +289,13 @@ func TestRangeDB_Prune(t *testing.T) {
 					tt.expectedError,
 				)
 			}
+			if numPruned != tt.expectedPruned {
+				t.Fatalf(
+					"Prune() pruned = %d, expectedPruned %d",
+					numPruned,
+					tt.expectedPruned,
+				)
+			}
 
 			if tt.testFunc != nil {
 				tt.testFunc(t, rdb)
@@ -316,7 +343,7 @@ func TestRangeDB_Invariants(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				_ = rdb.Prune(0, 3)
+				_, _ = rdb.Prune(0, 3)
 				requireNotExist(t, rdb, 0, lastConsequetiveNilIndex(rdb))
 			},
 		},
@@ -338,7 +365,7 @@ func TestRangeDB_Invariants(t *testing.T) {
 			},
 			testFunc: func(t *testing.T, rdb *file.RangeDB) {
 				t.Helper()
-				if err := rdb.Prune(0, 25); err != nil {
+				if _, err := rdb.Prune(0, 25); err != nil {
 					t.Fatalf("Prune() error = %v", err)
 				}
 				_ = populateTestDB(rdb, 5, 10)
@@ -18,21 +18,31 @@ func (_m *Prunable) EXPECT() *Prunable_Expecter {
 }
 
 // Prune provides a mock function with given fields: start, end
-func (_m *Prunable) Prune(start uint64, end uint64) error {
+func (_m *Prunable) Prune(start uint64, end uint64) (uint64, error) {
 	ret := _m.Called(start, end)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Prune")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) (uint64, error)); ok {
+		return rf(start, end)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64) uint64); ok {
 		r0 = rf(start, end)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // Prunable_Prune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prune'
@@ -54,12 +64,12 @@ func (_c *Prunable_Prune_Call) Run(run func(start uint64, end uint64)) *Prunable
 	return _c
 }
 
-func (_c *Prunable_Prune_Call) Return(_a0 error) *Prunable_Prune_Call {
-	_c.Call.Return(_a0)
+func (_c *Prunable_Prune_Call) Return(_a0 uint64, _a1 error) *Prunable_Prune_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Prunable_Prune_Call) RunAndReturn(run func(uint64, uint64) error) *Prunable_Prune_Call {
+func (_c *Prunable_Prune_Call) RunAndReturn(run func(uint64, uint64) (uint64, error)) *Prunable_Prune_Call {
 	_c.Call.Return(run)
 	return _c
 }
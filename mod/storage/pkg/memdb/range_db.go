@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package memdb
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+)
+
+// ErrNotFound is returned when a key is not found in the database.
+var ErrNotFound = errors.New("memdb: key not found")
+
+// Compile-time assertion of prunable interface.
+var _ pruner.Prunable = (*RangeDB)(nil)
+
+// RangeDB is an in-memory database that stores versioned data. It keeps the
+// values of each index in a separate map, so that whole indices can be
+// pruned at once. Its contents are lost when the process exits.
+type RangeDB struct {
+	// mu protects indices.
+	mu sync.RWMutex
+	// indices maps an index to the values stored under it.
+	indices map[uint64]map[string][]byte
+}
+
+// NewRangeDB creates a new, empty RangeDB.
+func NewRangeDB() *RangeDB {
+	return &RangeDB{
+		indices: make(map[uint64]map[string][]byte),
+	}
+}
+
+// Get retrieves the value associated with the given index and key.
+func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	value, ok := db.indices[index][string(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+// Has checks if the given index and key exist in the database.
+func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	_, ok := db.indices[index][string(key)]
+	return ok, nil
+}
+
+// Set stores the value with the given index and key in the database.
+func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	values, ok := db.indices[index]
+	if !ok {
+		values = make(map[string][]byte)
+		db.indices[index] = values
+	}
+	values[string(key)] = value
+	return nil
+}
+
+// Delete removes the value associated with the given index and key from the
+// database.
+func (db *RangeDB) Delete(index uint64, key []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	values, ok := db.indices[index]
+	if !ok {
+		return nil
+	}
+	delete(values, string(key))
+	if len(values) == 0 {
+		delete(db.indices, index)
+	}
+	return nil
+}
+
+// Prune removes all values in the given range [start, end) from the db and
+// returns the number of indexes pruned.
+func (db *RangeDB) Prune(start, end uint64) (uint64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var pruned uint64
+	for index := range db.indices {
+		if index >= start && index < end {
+			delete(db.indices, index)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
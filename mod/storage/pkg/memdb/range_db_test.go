@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package memdb_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeDB(t *testing.T) {
+	rdb := memdb.NewRangeDB()
+
+	exists, err := rdb.Has(1, []byte("testKey"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	_, err = rdb.Get(1, []byte("testKey"))
+	require.ErrorIs(t, err, memdb.ErrNotFound)
+
+	require.NoError(t, rdb.Set(1, []byte("testKey"), []byte("testValue")))
+	value, err := rdb.Get(1, []byte("testKey"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("testValue"), value)
+
+	// The same key under another index is a different entry.
+	exists, err = rdb.Has(2, []byte("testKey"))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, rdb.Delete(1, []byte("testKey")))
+	exists, err = rdb.Has(1, []byte("testKey"))
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestRangeDB_Prune(t *testing.T) {
+	rdb := memdb.NewRangeDB()
+	for index := uint64(0); index < 10; index++ {
+		require.NoError(t, rdb.Set(index, []byte("key"), []byte("value")))
+	}
+
+	numPruned, err := rdb.Prune(2, 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), numPruned)
+	for index := uint64(0); index < 10; index++ {
+		exists, err := rdb.Has(index, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, index < 2 || index >= 7, exists, "index %d", index)
+	}
+}
@@ -28,8 +28,9 @@ package pruner
 import "context"
 
 type Prunable interface {
-	// Prune prunes the store from [start, end).
-	Prune(start, end uint64) error
+	// Prune prunes the store from [start, end) and returns the number of
+	// indexes pruned.
+	Prune(start, end uint64) (uint64, error)
 }
 
 // Pruner is an interface for pruning the store.
@@ -84,11 +84,20 @@ func (p *DBPruner[
 			case event := <-ch:
 				if event.Is(events.BeaconBlockFinalized) {
 					start, end := p.pruneRangeFn(event)
-					if err := p.prunable.Prune(start, end); err != nil {
+					numPruned, err := p.prunable.Prune(start, end)
+					if err != nil {
 						p.logger.Error(
 							"‼️ error pruning index ‼️",
 							"error", err,
 						)
+						continue
+					}
+					if numPruned > 0 {
+						p.logger.Debug(
+							"pruned indexes",
+							"name", p.name,
+							"num_pruned", numPruned,
+						)
 					}
 				}
 			}
@@ -94,7 +94,7 @@ func TestPruner(t *testing.T) {
 
 			mockPrunable := new(interfacemocks.Prunable)
 			mockPrunable.On("Prune", mock.Anything, mock.Anything).
-				Return(nil)
+				Return(uint64(0), nil)
 
 			// create Pruner with a Noop logger
 			testPruner := pruner.NewPruner[